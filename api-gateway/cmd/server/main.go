@@ -7,8 +7,10 @@ import (
 
 	grpcclient "apigateway/internal/grpc"
 	"apigateway/internal/handler"
+	"apigateway/internal/handler/stream"
 	"apigateway/internal/repo"
 	"apigateway/internal/scheduler"
+	"apigateway/internal/tracker"
 )
 
 func main() {
@@ -17,20 +19,48 @@ func main() {
 		log.Fatal(err)
 	}
 
-	memStore := repo.NewMemoryStore()
+	store, err := repo.NewStoreFromEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
 	tgClient := repo.NewClient("8260354429:AAF8xVrZuimJaxMbr43PtMZAAftwFKelXVE", "1912920643")
 
+	subs := repo.NewSubscriptionStore()
+	bot := repo.NewBot(tgClient, subs, store, "smc-onboard")
+	bot.Start()
+
+	bus := repo.NewSignalBus()
+
+	outcomeStore := repo.NewMemoryOutcomeStore()
+	trk := tracker.NewTracker(smcClient, outcomeStore, tgClient, subs)
+	trk.Start()
+
+	confluenceStore := repo.NewMemoryConfluenceStore()
+
+	scanCfg, err := scheduler.LoadConfig("")
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	scanner := scheduler.NewScanner(
 		smcClient,
-		memStore,
+		store,
+		confluenceStore,
 		tgClient,
-		[]string{"BTCUSDT", "ETHUSDT", "BNBUSDT", "ZECUSDT", "XRPUSDT", "SOLUSDT"},
+		subs,
+		bus,
+		trk,
+		scanCfg,
 	)
 	scanner.Start()
 
 	gin.SetMode(gin.ReleaseMode)
 	r := gin.Default()
-	handler.RegisterRoutes(r, memStore)
+	handler.RegisterRoutes(r, store, smcClient, scanCfg.Confluence.Timeframes)
+	handler.RegisterExchangeRoutes(r, scanCfg.Exchanges)
+	handler.RegisterStatsRoutes(r, outcomeStore)
+	handler.RegisterConfluenceRoutes(r, confluenceStore)
+	stream.RegisterRoutes(r, bus)
 
 	log.Println("🚀 Go Gateway running on :9000")
 	r.Run(":9000")
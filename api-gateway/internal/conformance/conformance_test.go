@@ -0,0 +1,121 @@
+package conformance
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	grpcclient "apigateway/internal/grpc"
+	smcpb "apigateway/proto/smcpb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const bufSize = 1024 * 1024
+
+// fakeSMCService stands in for the real analysis engine: on each Analyze
+// call it looks up the vector matching the request's symbol/timeframe/
+// exchange (the only fields the real wire protocol carries) and runs
+// referenceAnalyze over *that vector's Input candles*, so the response is
+// actually derived from the replayed OHLCV series rather than handed back
+// from the vector's own Expected field.
+type fakeSMCService struct {
+	smcpb.UnimplementedSMCServiceServer
+	byKey map[string]Vector
+}
+
+func (f *fakeSMCService) Analyze(ctx context.Context, req *smcpb.AnalyzeRequest) (*smcpb.AnalyzeResponse, error) {
+	v, ok := f.byKey[vectorKey(req.Symbol, req.Timeframe, req.Exchange)]
+	if !ok {
+		return &smcpb.AnalyzeResponse{Symbol: req.Symbol, Timeframe: req.Timeframe}, nil
+	}
+
+	sig, ok := referenceAnalyze(v.Input)
+	if !ok {
+		return &smcpb.AnalyzeResponse{Symbol: req.Symbol, Timeframe: req.Timeframe}, nil
+	}
+	return &smcpb.AnalyzeResponse{
+		Symbol:     req.Symbol,
+		Timeframe:  req.Timeframe,
+		Side:       sig.Side,
+		EntryLow:   sig.EntryLow,
+		EntryHigh:  sig.EntryHigh,
+		StopLoss:   sig.StopLoss,
+		TakeProfit: sig.TakeProfit,
+	}, nil
+}
+
+func vectorKey(symbol, timeframe, exchange string) string {
+	return symbol + "_" + timeframe + "_" + exchange
+}
+
+// TestVectors replays every vector in VectorsDir() through SMCClient.Analyze
+// against an in-process fake SMC service and asserts the response matches
+// within each vector's tolerance, so regressions in the SMC algorithm's
+// output shape are caught before deployment.
+func TestVectors(t *testing.T) {
+	vectors, err := LoadVectors(VectorsDir())
+	if err != nil {
+		t.Fatalf("load vectors: %v", err)
+	}
+	if len(vectors) == 0 {
+		t.Fatal("no conformance vectors found")
+	}
+
+	byKey := make(map[string]Vector, len(vectors))
+	for _, v := range vectors {
+		byKey[vectorKey(v.Symbol, v.Timeframe, v.Exchange)] = v
+	}
+
+	lis := bufconn.Listen(bufSize)
+	server := grpc.NewServer()
+	smcpb.RegisterSMCServiceServer(server, &fakeSMCService{byKey: byKey})
+	go server.Serve(lis)
+	defer server.Stop()
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		t.Fatalf("dial fake SMC service: %v", err)
+	}
+	defer conn.Close()
+
+	client := grpcclient.NewSMCClientFromConn(conn)
+	report := junitReport{Name: "conformance.TestVectors"}
+
+	for _, v := range vectors {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			start := time.Now()
+			tc := junitTestCase{Name: v.Name, ClassName: v.Pattern}
+			defer func() {
+				tc.TimeSeconds = time.Since(start).Seconds()
+				report.TestCases = append(report.TestCases, tc)
+			}()
+
+			resp, err := client.Analyze(v.Symbol, v.Timeframe, v.Exchange)
+			if err != nil {
+				tc.Failure = &junitFailure{Message: "analyze error", Text: err.Error()}
+				t.Fatalf("analyze: %v", err)
+			}
+
+			actual := ExpectedSignal{
+				Side:       resp.Side,
+				EntryLow:   resp.EntryLow,
+				EntryHigh:  resp.EntryHigh,
+				StopLoss:   resp.StopLoss,
+				TakeProfit: resp.TakeProfit,
+			}
+			if err := v.Check(actual); err != nil {
+				tc.Failure = &junitFailure{Message: "vector mismatch", Text: err.Error()}
+				t.Error(err)
+			}
+		})
+	}
+
+	writeJUnitReport(t, report)
+}
@@ -0,0 +1,23 @@
+package conformance
+
+import "fmt"
+
+// mismatchError reports a string field that didn't match exactly (e.g. side).
+type mismatchError struct {
+	field      string
+	got, want string
+}
+
+func (e *mismatchError) Error() string {
+	return fmt.Sprintf("%s: got %q, want %q", e.field, e.got, e.want)
+}
+
+// toleranceError reports a float field that fell outside its tolerance band.
+type toleranceError struct {
+	field           string
+	got, want, tolerance float64
+}
+
+func (e *toleranceError) Error() string {
+	return fmt.Sprintf("%s: got %.6f, want %.6f (tolerance %.6f)", e.field, e.got, e.want, e.tolerance)
+}
@@ -0,0 +1,50 @@
+package conformance
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// defaultVectorsDir is the corpus checked into this repo.
+const defaultVectorsDir = "testdata/vectors"
+
+// VectorsDir resolves where to load the vector corpus from. If VECTORS_BRANCH
+// is set, vectors are pulled from a separate versioned vectors repo checked
+// out as a sibling submodule at that branch; otherwise the in-repo corpus
+// under defaultVectorsDir is used.
+func VectorsDir() string {
+	if branch := os.Getenv("VECTORS_BRANCH"); branch != "" {
+		return filepath.Join("testdata", "vectors-"+branch)
+	}
+	return defaultVectorsDir
+}
+
+// LoadVectors reads every *.json vector from dir.
+func LoadVectors(dir string) ([]Vector, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read vectors dir %s: %w", dir, err)
+	}
+
+	var vectors []Vector
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", path, err)
+		}
+
+		var v Vector
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, fmt.Errorf("parse %s: %w", path, err)
+		}
+		vectors = append(vectors, v)
+	}
+	return vectors, nil
+}
@@ -0,0 +1,59 @@
+package conformance
+
+// referenceAnalyze is a deliberately simplified stand-in for the real SMC
+// engine, used only by the conformance harness's fake gRPC service so
+// TestVectors actually exercises Vector.Input instead of being handed the
+// answer key. It looks for a break of the prior swing high/low, treats the
+// candle immediately before the break as the order block (the entry zone),
+// and places the stop beyond the swept swing point with the take profit at
+// 2R. It is not the production algorithm.
+func referenceAnalyze(bars []OHLCVBar) (ExpectedSignal, bool) {
+	if len(bars) < 3 {
+		return ExpectedSignal{}, false
+	}
+
+	prior := bars[:len(bars)-1]
+	last := bars[len(bars)-1]
+
+	swingHigh, swingLow := prior[0].High, prior[0].Low
+	for _, b := range prior {
+		if b.High > swingHigh {
+			swingHigh = b.High
+		}
+		if b.Low < swingLow {
+			swingLow = b.Low
+		}
+	}
+	buffer := 0.1 * (swingHigh - swingLow)
+
+	orderBlock := bars[len(bars)-2]
+	entryLow, entryHigh := orderBlock.Open, orderBlock.Close
+	if entryLow > entryHigh {
+		entryLow, entryHigh = entryHigh, entryLow
+	}
+	mid := (entryLow + entryHigh) / 2
+
+	switch {
+	case last.Close > swingHigh:
+		stopLoss := swingLow - buffer
+		risk := mid - stopLoss
+		return ExpectedSignal{
+			Side:       "BUY",
+			EntryLow:   entryLow,
+			EntryHigh:  entryHigh,
+			StopLoss:   stopLoss,
+			TakeProfit: mid + 2*risk,
+		}, true
+	case last.Close < swingLow:
+		stopLoss := swingHigh + buffer
+		risk := stopLoss - mid
+		return ExpectedSignal{
+			Side:       "SELL",
+			EntryLow:   entryLow,
+			EntryHigh:  entryHigh,
+			StopLoss:   stopLoss,
+			TakeProfit: mid - 2*risk,
+		}, true
+	}
+	return ExpectedSignal{}, false
+}
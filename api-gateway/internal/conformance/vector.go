@@ -0,0 +1,66 @@
+// Package conformance replays fixed OHLCV input series through the SMC
+// analysis service and checks the emitted signal against a pinned expected
+// output, the same test-vector approach Filecoin/Lotus uses to pin consensus
+// behavior: a corpus of input/output pairs checked into the repo rather than
+// hand-written assertions per pattern.
+package conformance
+
+// OHLCVBar is one candle in a test vector's input series.
+type OHLCVBar struct {
+	Time   int64   `json:"time"`
+	Open   float64 `json:"open"`
+	High   float64 `json:"high"`
+	Low    float64 `json:"low"`
+	Close  float64 `json:"close"`
+	Volume float64 `json:"volume"`
+}
+
+// ExpectedSignal is the tolerance-checked shape of an AnalyzeResponse.
+type ExpectedSignal struct {
+	Side       string  `json:"side"`
+	EntryLow   float64 `json:"entry_low"`
+	EntryHigh  float64 `json:"entry_high"`
+	StopLoss   float64 `json:"stop_loss"`
+	TakeProfit float64 `json:"take_profit"`
+}
+
+// Vector is a single conformance test case: Input is replayed through the
+// analysis engine (the fake service in TestVectors, or a real one), and the
+// response must match Expected within Tolerance.
+type Vector struct {
+	Name      string         `json:"name"`
+	Pattern   string         `json:"pattern"` // order_block, bos, choch, liquidity_sweep
+	Symbol    string         `json:"symbol"`
+	Timeframe string         `json:"timeframe"`
+	Exchange  string         `json:"exchange"`
+	Input     []OHLCVBar     `json:"input"`
+	Expected  ExpectedSignal `json:"expected"`
+	Tolerance float64        `json:"tolerance"`
+}
+
+// fieldDiff is one compared field between an actual and expected signal.
+type fieldDiff struct {
+	name     string
+	got, want float64
+}
+
+// Check compares actual against v's expected signal within v's tolerance.
+func (v Vector) Check(actual ExpectedSignal) error {
+	if actual.Side != v.Expected.Side {
+		return &mismatchError{field: "side", got: actual.Side, want: v.Expected.Side}
+	}
+
+	diffs := []fieldDiff{
+		{"entry_low", actual.EntryLow, v.Expected.EntryLow},
+		{"entry_high", actual.EntryHigh, v.Expected.EntryHigh},
+		{"stop_loss", actual.StopLoss, v.Expected.StopLoss},
+		{"take_profit", actual.TakeProfit, v.Expected.TakeProfit},
+	}
+	for _, d := range diffs {
+		delta := d.got - d.want
+		if delta < -v.Tolerance || delta > v.Tolerance {
+			return &toleranceError{field: d.name, got: d.got, want: d.want, tolerance: v.Tolerance}
+		}
+	}
+	return nil
+}
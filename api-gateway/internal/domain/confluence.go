@@ -0,0 +1,14 @@
+package domain
+
+import "time"
+
+// ConfluenceSignal marks that every configured timeframe for a symbol agrees
+// on the same side within the staleness window, referencing the per-timeframe
+// Signal IDs that contributed to it.
+type ConfluenceSignal struct {
+	Symbol     string    `json:"symbol"`
+	Side       string    `json:"side"`
+	Timeframes []string  `json:"timeframes"`
+	SignalIDs  []string  `json:"signal_ids"`
+	DetectedAt time.Time `json:"detected_at"`
+}
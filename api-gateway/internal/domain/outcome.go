@@ -0,0 +1,32 @@
+package domain
+
+import "time"
+
+// OutcomeStatus tracks a Signal's lifecycle against live price.
+type OutcomeStatus string
+
+const (
+	OutcomeOpen        OutcomeStatus = "open"
+	OutcomeEntryFilled OutcomeStatus = "entry_filled"
+	OutcomeTPHit       OutcomeStatus = "tp_hit"
+	OutcomeSLHit       OutcomeStatus = "sl_hit"
+	OutcomeExpired     OutcomeStatus = "expired"
+)
+
+// Outcome closes the loop on a fire-and-forget Signal by recording how it
+// resolved against live price.
+type Outcome struct {
+	Signal     Signal        `json:"signal"`
+	Status     OutcomeStatus `json:"status"`
+	RMultiple  float64       `json:"r_multiple"`
+	ResolvedAt time.Time     `json:"resolved_at,omitempty"`
+}
+
+// Resolved reports whether the outcome has reached a terminal state.
+func (o Outcome) Resolved() bool {
+	switch o.Status {
+	case OutcomeTPHit, OutcomeSLHit, OutcomeExpired:
+		return true
+	}
+	return false
+}
@@ -1,11 +1,61 @@
 package domain
 
+import (
+	"fmt"
+	"time"
+)
+
 type Signal struct {
-	Symbol     string  `json:"symbol"`
-	Timeframe  string  `json:"timeframe"`
-	Side       string  `json:"side"`
-	EntryLow   float64 `json:"entry_low"`
-	EntryHigh  float64 `json:"entry_high"`
-	StopLoss   float64 `json:"stop_loss"`
-	TakeProfit float64 `json:"take_profit"`
+	ID         string    `json:"id"`
+	Symbol     string    `json:"symbol"`
+	Timeframe  string    `json:"timeframe"`
+	Exchange   string    `json:"exchange"`
+	Side       string    `json:"side"`
+	EntryLow   float64   `json:"entry_low"`
+	EntryHigh  float64   `json:"entry_high"`
+	StopLoss   float64   `json:"stop_loss"`
+	TakeProfit float64   `json:"take_profit"`
+	EmittedAt  time.Time `json:"emitted_at"`
+}
+
+// NewSignalID derives a stable ID from the fields that make a signal unique,
+// so ConfluenceSignal can reference contributing signals without a store round-trip.
+func NewSignalID(symbol, timeframe string, emittedAt time.Time) string {
+	return fmt.Sprintf("%s_%s_%d", symbol, timeframe, emittedAt.UnixNano())
+}
+
+// SignalStatus is a derived classification of a signal against live price,
+// computed by the handler rather than stored.
+type SignalStatus string
+
+const (
+	StatusPending SignalStatus = "pending"
+	StatusActive  SignalStatus = "active"
+	StatusHitTP   SignalStatus = "hit-tp"
+	StatusHitSL   SignalStatus = "hit-sl"
+)
+
+// SignalView decorates a stored Signal with fields derived at read time from
+// live price data (risk:reward, age, status).
+type SignalView struct {
+	Signal
+	RiskReward float64      `json:"risk_reward"`
+	AgeSeconds float64      `json:"age_seconds"`
+	Status     SignalStatus `json:"status"`
+}
+
+// RiskReward is the static reward:risk ratio implied by entry/SL/TP, used by
+// Store.Query's min_rr filter. It does not require live price.
+func (s Signal) RiskReward() float64 {
+	entryMid := (s.EntryLow + s.EntryHigh) / 2
+	risk := entryMid - s.StopLoss
+	reward := s.TakeProfit - entryMid
+	if s.Side == "SELL" {
+		risk = s.StopLoss - entryMid
+		reward = entryMid - s.TakeProfit
+	}
+	if risk <= 0 {
+		return 0
+	}
+	return reward / risk
 }
@@ -0,0 +1,42 @@
+package domain
+
+import "testing"
+
+func TestSignalRiskReward(t *testing.T) {
+	tests := []struct {
+		name string
+		sig  Signal
+		want float64
+	}{
+		{
+			name: "buy with positive risk",
+			// entryMid=110, risk=110-90=20, reward=180-110=70 -> 3.5
+			sig:  Signal{Side: "BUY", EntryLow: 100, EntryHigh: 120, StopLoss: 90, TakeProfit: 180},
+			want: 3.5,
+		},
+		{
+			name: "sell with positive risk",
+			// entryMid=100, risk=120-100=20, reward=100-20=80 -> 4
+			sig:  Signal{Side: "SELL", EntryLow: 90, EntryHigh: 110, StopLoss: 120, TakeProfit: 20},
+			want: 4,
+		},
+		{
+			name: "zero risk returns zero",
+			sig:  Signal{Side: "BUY", EntryLow: 100, EntryHigh: 100, StopLoss: 100, TakeProfit: 150},
+			want: 0,
+		},
+		{
+			name: "negative risk (stop beyond entry) returns zero",
+			sig:  Signal{Side: "BUY", EntryLow: 100, EntryHigh: 100, StopLoss: 110, TakeProfit: 150},
+			want: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.sig.RiskReward(); got != tt.want {
+				t.Errorf("RiskReward() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
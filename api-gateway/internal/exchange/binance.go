@@ -0,0 +1,46 @@
+package exchange
+
+import (
+	"sort"
+	"strings"
+)
+
+type binanceExchange struct {
+	ticks map[string][2]float64 // symbol -> [priceTick, qtyTick]
+}
+
+func init() {
+	register(&binanceExchange{
+		ticks: map[string][2]float64{
+			"BTCUSDT": {0.01, 0.00001},
+			"ETHUSDT": {0.01, 0.0001},
+			"BNBUSDT": {0.01, 0.001},
+			"ZECUSDT": {0.01, 0.001},
+			"XRPUSDT": {0.0001, 1},
+			"SOLUSDT": {0.001, 0.01},
+		},
+	})
+}
+
+func (b *binanceExchange) Kind() string { return "binance" }
+
+func (b *binanceExchange) Normalize(symbol string) string {
+	return strings.ToUpper(symbol)
+}
+
+func (b *binanceExchange) TickSize(symbol string) (float64, float64, error) {
+	tick, ok := b.ticks[b.Normalize(symbol)]
+	if !ok {
+		return 0, 0, ErrUnknownSymbol
+	}
+	return tick[0], tick[1], nil
+}
+
+func (b *binanceExchange) Symbols() []string {
+	symbols := make([]string, 0, len(b.ticks))
+	for s := range b.ticks {
+		symbols = append(symbols, s)
+	}
+	sort.Strings(symbols)
+	return symbols
+}
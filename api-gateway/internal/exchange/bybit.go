@@ -0,0 +1,45 @@
+package exchange
+
+import (
+	"sort"
+	"strings"
+)
+
+type bybitExchange struct {
+	ticks map[string][2]float64
+}
+
+func init() {
+	register(&bybitExchange{
+		ticks: map[string][2]float64{
+			"BTCUSDT": {0.5, 0.001},
+			"ETHUSDT": {0.05, 0.01},
+			"BNBUSDT": {0.01, 0.01},
+			"XRPUSDT": {0.0001, 1},
+			"SOLUSDT": {0.01, 0.1},
+		},
+	})
+}
+
+func (b *bybitExchange) Kind() string { return "bybit" }
+
+func (b *bybitExchange) Normalize(symbol string) string {
+	return strings.ToUpper(symbol)
+}
+
+func (b *bybitExchange) TickSize(symbol string) (float64, float64, error) {
+	tick, ok := b.ticks[b.Normalize(symbol)]
+	if !ok {
+		return 0, 0, ErrUnknownSymbol
+	}
+	return tick[0], tick[1], nil
+}
+
+func (b *bybitExchange) Symbols() []string {
+	symbols := make([]string, 0, len(b.ticks))
+	for s := range b.ticks {
+		symbols = append(symbols, s)
+	}
+	sort.Strings(symbols)
+	return symbols
+}
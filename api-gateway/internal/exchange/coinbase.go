@@ -0,0 +1,50 @@
+package exchange
+
+import (
+	"sort"
+	"strings"
+)
+
+// coinbaseExchange normalizes Binance-style "BTCUSDT" symbols into Coinbase's
+// native "BTC-USD" product ID format.
+type coinbaseExchange struct {
+	ticks map[string][2]float64 // keyed by normalized "BASE-USD"
+}
+
+func init() {
+	register(&coinbaseExchange{
+		ticks: map[string][2]float64{
+			"BTC-USD": {0.01, 0.00000001},
+			"ETH-USD": {0.01, 0.00000001},
+			"SOL-USD": {0.01, 0.000001},
+			"XRP-USD": {0.0001, 1},
+		},
+	})
+}
+
+func (c *coinbaseExchange) Kind() string { return "coinbase" }
+
+func (c *coinbaseExchange) Normalize(symbol string) string {
+	symbol = strings.ToUpper(symbol)
+	if base := strings.TrimSuffix(symbol, "USDT"); base != symbol {
+		return base + "-USD"
+	}
+	return symbol
+}
+
+func (c *coinbaseExchange) TickSize(symbol string) (float64, float64, error) {
+	tick, ok := c.ticks[c.Normalize(symbol)]
+	if !ok {
+		return 0, 0, ErrUnknownSymbol
+	}
+	return tick[0], tick[1], nil
+}
+
+func (c *coinbaseExchange) Symbols() []string {
+	symbols := make([]string, 0, len(c.ticks))
+	for s := range c.ticks {
+		symbols = append(symbols, s)
+	}
+	sort.Strings(symbols)
+	return symbols
+}
@@ -0,0 +1,54 @@
+// Package exchange provides per-venue symbol normalization and tick-size
+// precision, in the spirit of goex's TickSize struct, so the same pair can be
+// analyzed and rounded consistently across multiple exchanges.
+package exchange
+
+import "errors"
+
+// Exchange exposes what Scanner needs to analyze a symbol on a specific venue.
+type Exchange interface {
+	// Kind is the adapter's registry key, e.g. "binance".
+	Kind() string
+	// Normalize converts a generic symbol (e.g. "BTCUSDT") into this venue's
+	// native format (e.g. Coinbase's "BTC-USD").
+	Normalize(symbol string) string
+	// TickSize returns the price and quantity precision for symbol.
+	TickSize(symbol string) (priceTick, qtyTick float64, err error)
+	// Symbols lists every symbol this adapter has precision data for.
+	Symbols() []string
+}
+
+// ErrUnknownSymbol is returned by TickSize when an adapter has no precision
+// data for the given symbol.
+var ErrUnknownSymbol = errors.New("exchange: unknown symbol")
+
+var registry = map[string]Exchange{}
+
+func register(e Exchange) {
+	registry[e.Kind()] = e
+}
+
+// Get looks up a registered adapter by kind (e.g. "binance", "bybit").
+func Get(kind string) (Exchange, bool) {
+	e, ok := registry[kind]
+	return e, ok
+}
+
+// All returns every registered adapter.
+func All() []Exchange {
+	all := make([]Exchange, 0, len(registry))
+	for _, e := range registry {
+		all = append(all, e)
+	}
+	return all
+}
+
+// RoundToTick rounds v down to the nearest multiple of tick. A non-positive
+// tick is treated as "no rounding" so callers don't need to special-case
+// symbols without precision data.
+func RoundToTick(v, tick float64) float64 {
+	if tick <= 0 {
+		return v
+	}
+	return float64(int64(v/tick)) * tick
+}
@@ -0,0 +1,46 @@
+package exchange
+
+import "testing"
+
+func TestRoundToTick(t *testing.T) {
+	tests := []struct {
+		name string
+		v    float64
+		tick float64
+		want float64
+	}{
+		{"rounds down to nearest tick", 61234.578, 0.01, 61234.57},
+		{"exact multiple is unchanged", 100, 0.5, 100},
+		{"non-positive tick is a no-op", 61234.578, 0, 61234.578},
+		{"negative tick is a no-op", 61234.578, -1, 61234.578},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RoundToTick(tt.v, tt.tick); got != tt.want {
+				t.Errorf("RoundToTick(%v, %v) = %v, want %v", tt.v, tt.tick, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetReturnsRegisteredAdapters(t *testing.T) {
+	for _, kind := range []string{"binance", "bybit", "okx", "coinbase"} {
+		if _, ok := Get(kind); !ok {
+			t.Errorf("Get(%q) not found; expected it to self-register via init()", kind)
+		}
+	}
+	if _, ok := Get("not-a-real-exchange"); ok {
+		t.Error("Get of an unregistered kind should report not found")
+	}
+}
+
+func TestCoinbaseNormalizeRewritesUSDTPairs(t *testing.T) {
+	cb, ok := Get("coinbase")
+	if !ok {
+		t.Fatal("coinbase adapter not registered")
+	}
+	if got := cb.Normalize("BTCUSDT"); got != "BTC-USD" {
+		t.Errorf("Normalize(BTCUSDT) = %q, want BTC-USD", got)
+	}
+}
@@ -0,0 +1,44 @@
+package exchange
+
+import (
+	"sort"
+	"strings"
+)
+
+type okxExchange struct {
+	ticks map[string][2]float64
+}
+
+func init() {
+	register(&okxExchange{
+		ticks: map[string][2]float64{
+			"BTCUSDT": {0.1, 0.0001},
+			"ETHUSDT": {0.01, 0.001},
+			"XRPUSDT": {0.0001, 1},
+			"SOLUSDT": {0.001, 0.01},
+		},
+	})
+}
+
+func (o *okxExchange) Kind() string { return "okx" }
+
+func (o *okxExchange) Normalize(symbol string) string {
+	return strings.ToUpper(symbol)
+}
+
+func (o *okxExchange) TickSize(symbol string) (float64, float64, error) {
+	tick, ok := o.ticks[o.Normalize(symbol)]
+	if !ok {
+		return 0, 0, ErrUnknownSymbol
+	}
+	return tick[0], tick[1], nil
+}
+
+func (o *okxExchange) Symbols() []string {
+	symbols := make([]string, 0, len(o.ticks))
+	for s := range o.ticks {
+		symbols = append(symbols, s)
+	}
+	sort.Strings(symbols)
+	return symbols
+}
@@ -24,6 +24,13 @@ func NewSMCClient(addr string) (*SMCClient, error) {
 	}, nil
 }
 
+// NewSMCClientFromConn wraps an already-established connection, letting
+// callers (e.g. the conformance test harness) point the client at an
+// in-process fake service instead of dialing a real address.
+func NewSMCClientFromConn(conn *grpc.ClientConn) *SMCClient {
+	return &SMCClient{client: smcpb.NewSMCServiceClient(conn)}
+}
+
 // Analyze fetches signal from SMC service for a given symbol, timeframe, and exchange
 func (s *SMCClient) Analyze(symbol, timeframe, exchange string) (*smcpb.AnalyzeResponse, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -35,3 +42,19 @@ func (s *SMCClient) Analyze(symbol, timeframe, exchange string) (*smcpb.AnalyzeR
 		Exchange:  exchange,
 	})
 }
+
+// CurrentPrice fetches the latest traded price for a symbol on an exchange,
+// used by the handler to derive a signal's live status (pending/active/hit-tp/hit-sl).
+func (s *SMCClient) CurrentPrice(symbol, exchange string) (float64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := s.client.GetPrice(ctx, &smcpb.PriceRequest{
+		Symbol:   symbol,
+		Exchange: exchange,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.Price, nil
+}
@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"apigateway/internal/repo"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterConfluenceRoutes exposes past confluence events so they can be
+// queried instead of only ever reaching a log line and a Telegram message.
+func RegisterConfluenceRoutes(r *gin.Engine, store repo.ConfluenceStore) {
+	r.GET("/confluence/:symbol", func(c *gin.Context) {
+		symbol := c.Param("symbol")
+		limit, _ := strconv.Atoi(c.DefaultQuery("limit", "50"))
+
+		events, err := store.ForSymbol(symbol, limit)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, events)
+	})
+}
@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"net/http"
+
+	"apigateway/internal/exchange"
+
+	"github.com/gin-gonic/gin"
+)
+
+type exchangeInfo struct {
+	Kind    string   `json:"kind"`
+	Symbols []string `json:"symbols"`
+}
+
+// RegisterExchangeRoutes exposes GET /exchanges, listing the venues the
+// scanner is configured to analyze and the symbols each supports.
+func RegisterExchangeRoutes(r *gin.Engine, kinds []string) {
+	r.GET("/exchanges", func(c *gin.Context) {
+		infos := make([]exchangeInfo, 0, len(kinds))
+		for _, kind := range kinds {
+			ex, ok := exchange.Get(kind)
+			if !ok {
+				continue
+			}
+			infos = append(infos, exchangeInfo{Kind: ex.Kind(), Symbols: ex.Symbols()})
+		}
+		c.JSON(http.StatusOK, infos)
+	})
+}
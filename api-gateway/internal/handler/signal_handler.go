@@ -2,28 +2,34 @@ package handler
 
 import (
 	"net/http"
+	"strconv"
+	"time"
 
 	"apigateway/internal/domain"
+	grpcclient "apigateway/internal/grpc"
 	"apigateway/internal/repo"
 
 	"github.com/gin-gonic/gin"
 )
 
-func RegisterRoutes(r *gin.Engine, store *repo.MemoryStore) {
+// defaultExchange is assumed when a request doesn't specify one, matching
+// the venue the scanner has always defaulted to.
+const defaultExchange = "binance"
 
-	// Return all signals
-	// r.GET("/signals", func(c *gin.Context) {
-	// 	signals := store.All() // All() returns []repo.Signal
-	// 	c.JSON(http.StatusOK, signals)
-	// })
+// RegisterRoutes wires up the signal HTTP endpoints. timeframes is the full
+// configured timeframe matrix (scheduler.Config.Confluence.Timeframes),
+// queried when a request doesn't name one so newly-added timeframes (e.g.
+// "4h", or whatever a deployment's YAML adds) show up here too.
+func RegisterRoutes(r *gin.Engine, store repo.Store, smc *grpcclient.SMCClient, timeframes []string) {
 
 	// Return signals for a specific symbol
 	r.GET("/signals/:symbol", func(c *gin.Context) {
 		symbol := c.Param("symbol")
 		timeframe := c.Query("timeframe") // optional query param: ?timeframe=15m
+		exchange := c.DefaultQuery("exchange", defaultExchange)
 
 		if timeframe != "" {
-			if sig, ok := store.Get(symbol, timeframe); ok {
+			if sig, ok, err := store.Latest(symbol, timeframe, exchange); err == nil && ok {
 				c.JSON(http.StatusOK, sig)
 				return
 			}
@@ -31,13 +37,105 @@ func RegisterRoutes(r *gin.Engine, store *repo.MemoryStore) {
 			return
 		}
 
-		// If no timeframe is provided, return all signals for this symbol
+		// If no timeframe is provided, return the latest signal on every
+		// configured timeframe for this symbol
 		var results []domain.Signal
-		for _, tf := range []string{"15m", "1h"} {
-			if sig, ok := store.Get(symbol, tf); ok {
+		for _, tf := range timeframes {
+			if sig, ok, err := store.Latest(symbol, tf, exchange); err == nil && ok {
 				results = append(results, sig)
 			}
 		}
 		c.JSON(http.StatusOK, results)
 	})
+
+	// Return paginated signal history for a symbol/timeframe
+	r.GET("/signals/:symbol/history", func(c *gin.Context) {
+		symbol := c.Param("symbol")
+		timeframe := c.DefaultQuery("timeframe", "15m")
+		exchange := c.DefaultQuery("exchange", defaultExchange)
+
+		from, _ := parseTime(c.Query("from"))
+		to, _ := parseTime(c.Query("to"))
+		limit, _ := strconv.Atoi(c.DefaultQuery("limit", "100"))
+
+		signals, err := store.History(symbol, timeframe, exchange, from, to, limit)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, decorate(signals, smc))
+	})
+
+	// Cross-symbol filtering, e.g. /signals?side=BUY&min_rr=2.0
+	r.GET("/signals", func(c *gin.Context) {
+		minRR, _ := strconv.ParseFloat(c.Query("min_rr"), 64)
+		filter := repo.SignalFilter{
+			Side:  c.Query("side"),
+			MinRR: minRR,
+		}
+
+		signals, err := store.Query(filter)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, decorate(signals, smc))
+	})
+}
+
+func parseTime(v string) (time.Time, error) {
+	if v == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, v)
+}
+
+// decorate joins live price from the gRPC service onto each signal to
+// compute risk:reward, age, and fill status.
+func decorate(signals []domain.Signal, smc *grpcclient.SMCClient) []domain.SignalView {
+	views := make([]domain.SignalView, 0, len(signals))
+	for _, sig := range signals {
+		views = append(views, domain.SignalView{
+			Signal:     sig,
+			RiskReward: sig.RiskReward(),
+			AgeSeconds: time.Since(sig.EmittedAt).Seconds(),
+			Status:     resolveStatus(sig, smc),
+		})
+	}
+	return views
+}
+
+func resolveStatus(sig domain.Signal, smc *grpcclient.SMCClient) domain.SignalStatus {
+	exchange := sig.Exchange
+	if exchange == "" {
+		exchange = defaultExchange
+	}
+
+	price, err := smc.CurrentPrice(sig.Symbol, exchange)
+	if err != nil {
+		return domain.StatusPending
+	}
+
+	if sig.Side == "BUY" {
+		switch {
+		case price >= sig.TakeProfit:
+			return domain.StatusHitTP
+		case price <= sig.StopLoss:
+			return domain.StatusHitSL
+		case price >= sig.EntryLow && price <= sig.EntryHigh:
+			return domain.StatusActive
+		}
+		return domain.StatusPending
+	}
+
+	// SELL
+	switch {
+	case price <= sig.TakeProfit:
+		return domain.StatusHitTP
+	case price >= sig.StopLoss:
+		return domain.StatusHitSL
+	case price >= sig.EntryLow && price <= sig.EntryHigh:
+		return domain.StatusActive
+	}
+	return domain.StatusPending
 }
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"apigateway/internal/domain"
+	"apigateway/internal/repo"
+
+	"github.com/gin-gonic/gin"
+)
+
+const defaultStatsWindow = 30 * 24 * time.Hour
+
+type statsResponse struct {
+	Symbol             string    `json:"symbol"`
+	Timeframe          string    `json:"timeframe"`
+	Trades             int       `json:"trades"`
+	WinRate            float64   `json:"win_rate"`
+	AvgRMultiple       float64   `json:"avg_r_multiple"`
+	Expectancy         float64   `json:"expectancy"`
+	MaxConsecutiveLoss int       `json:"max_consecutive_losses"`
+	PnLCurve           []float64 `json:"pnl_curve"`
+}
+
+// RegisterStatsRoutes exposes GET /stats, summarizing how tracked signals for
+// a symbol/timeframe performed over a trailing window.
+func RegisterStatsRoutes(r *gin.Engine, outcomes repo.OutcomeStore) {
+	r.GET("/stats", func(c *gin.Context) {
+		symbol := c.Query("symbol")
+		timeframe := c.DefaultQuery("timeframe", "15m")
+		since := time.Now().Add(-parseWindow(c.DefaultQuery("window", "30d")))
+
+		trades, err := outcomes.ForSymbol(symbol, timeframe, since)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, computeStats(symbol, timeframe, trades))
+	})
+}
+
+func parseWindow(v string) time.Duration {
+	v = strings.TrimSpace(v)
+	if days := strings.TrimSuffix(v, "d"); days != v {
+		if n, err := strconv.Atoi(days); err == nil {
+			return time.Duration(n) * 24 * time.Hour
+		}
+	}
+	if d, err := time.ParseDuration(v); err == nil {
+		return d
+	}
+	return defaultStatsWindow
+}
+
+// computeStats summarizes resolved (tp_hit/sl_hit) outcomes: win rate,
+// average R multiple, expectancy, max consecutive losses, and a running PnL
+// curve in R.
+func computeStats(symbol, timeframe string, trades []domain.Outcome) statsResponse {
+	resolved := make([]domain.Outcome, 0, len(trades))
+	for _, o := range trades {
+		if o.Status == domain.OutcomeTPHit || o.Status == domain.OutcomeSLHit {
+			resolved = append(resolved, o)
+		}
+	}
+	sort.Slice(resolved, func(i, j int) bool {
+		return resolved[i].ResolvedAt.Before(resolved[j].ResolvedAt)
+	})
+
+	resp := statsResponse{Symbol: symbol, Timeframe: timeframe, Trades: len(resolved)}
+	if len(resolved) == 0 {
+		return resp
+	}
+
+	var wins int
+	var rSum, cumulative float64
+	var consecutiveLosses int
+
+	for _, o := range resolved {
+		rSum += o.RMultiple
+		cumulative += o.RMultiple
+		resp.PnLCurve = append(resp.PnLCurve, cumulative)
+
+		if o.Status == domain.OutcomeTPHit {
+			wins++
+			consecutiveLosses = 0
+			continue
+		}
+		consecutiveLosses++
+		if consecutiveLosses > resp.MaxConsecutiveLoss {
+			resp.MaxConsecutiveLoss = consecutiveLosses
+		}
+	}
+
+	resp.WinRate = float64(wins) / float64(len(resolved))
+	resp.AvgRMultiple = rSum / float64(len(resolved))
+	resp.Expectancy = resp.AvgRMultiple
+	return resp
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"apigateway/internal/domain"
+)
+
+func TestParseWindow(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want time.Duration
+	}{
+		{"days suffix", "7d", 7 * 24 * time.Hour},
+		{"go duration string", "48h", 48 * time.Hour},
+		{"empty string falls back to default", "", defaultStatsWindow},
+		{"garbage falls back to default", "not-a-duration", defaultStatsWindow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseWindow(tt.in); got != tt.want {
+				t.Errorf("parseWindow(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestComputeStats(t *testing.T) {
+	now := time.Now()
+	outcomes := []domain.Outcome{
+		{Status: domain.OutcomeTPHit, RMultiple: 2, ResolvedAt: now.Add(-3 * time.Hour)},
+		{Status: domain.OutcomeSLHit, RMultiple: -1, ResolvedAt: now.Add(-2 * time.Hour)},
+		{Status: domain.OutcomeSLHit, RMultiple: -1, ResolvedAt: now.Add(-time.Hour)},
+		{Status: domain.OutcomeOpen, RMultiple: 0, ResolvedAt: time.Time{}}, // unresolved, excluded
+	}
+
+	resp := computeStats("BTCUSDT", "15m", outcomes)
+
+	if resp.Trades != 3 {
+		t.Fatalf("Trades = %d, want 3 (open outcomes excluded)", resp.Trades)
+	}
+	if resp.WinRate != 1.0/3.0 {
+		t.Errorf("WinRate = %v, want %v", resp.WinRate, 1.0/3.0)
+	}
+	if resp.AvgRMultiple != 0 {
+		t.Errorf("AvgRMultiple = %v, want 0", resp.AvgRMultiple)
+	}
+	if resp.MaxConsecutiveLoss != 2 {
+		t.Errorf("MaxConsecutiveLoss = %d, want 2", resp.MaxConsecutiveLoss)
+	}
+	wantCurve := []float64{2, 1, 0}
+	if len(resp.PnLCurve) != len(wantCurve) {
+		t.Fatalf("PnLCurve = %v, want %v", resp.PnLCurve, wantCurve)
+	}
+	for i, v := range wantCurve {
+		if resp.PnLCurve[i] != v {
+			t.Errorf("PnLCurve[%d] = %v, want %v", i, resp.PnLCurve[i], v)
+		}
+	}
+}
+
+func TestComputeStatsNoTrades(t *testing.T) {
+	resp := computeStats("BTCUSDT", "15m", nil)
+	if resp.Trades != 0 || len(resp.PnLCurve) != 0 {
+		t.Errorf("expected empty stats for no trades, got %+v", resp)
+	}
+}
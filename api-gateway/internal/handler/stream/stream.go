@@ -0,0 +1,144 @@
+// Package stream exposes latency-sensitive push endpoints (websocket and
+// SSE) backed by repo.SignalBus, complementing the pull-only GET
+// /signals/:symbol route.
+package stream
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"apigateway/internal/domain"
+	"apigateway/internal/repo"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+const heartbeatInterval = 20 * time.Second
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	CheckOrigin:     func(r *http.Request) bool { return true },
+}
+
+// subscribeFrame is the client->server message shape on the websocket.
+// {"action":"subscribe","symbols":["BTCUSDT"],"timeframes":["15m","1h"]}
+type subscribeFrame struct {
+	Action     string   `json:"action"`
+	Symbols    []string `json:"symbols"`
+	Timeframes []string `json:"timeframes"`
+}
+
+// RegisterRoutes wires /ws/signals and /sse/signals onto r.
+func RegisterRoutes(r *gin.Engine, bus *repo.SignalBus) {
+	r.GET("/ws/signals", func(c *gin.Context) {
+		serveWS(c, bus)
+	})
+	r.GET("/sse/signals", func(c *gin.Context) {
+		serveSSE(c, bus)
+	})
+}
+
+func topicsFor(symbols, timeframes []string) []string {
+	var topics []string
+	for _, s := range symbols {
+		for _, tf := range timeframes {
+			topics = append(topics, repo.Topic(s, tf))
+		}
+	}
+	return topics
+}
+
+func serveWS(c *gin.Context, bus *repo.SignalBus) {
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	ch, unsubscribe := bus.Subscribe(nil)
+	defer unsubscribe()
+	var topics []string
+
+	done := make(chan struct{})
+	go readSubscriptions(conn, bus, ch, &topics, done)
+
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case sig, ok := <-ch:
+			if !ok {
+				return
+			}
+			if err := conn.WriteJSON(sig); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+// readSubscriptions pumps incoming subscribe frames and keeps the bus topic
+// set for ch in sync with the client's latest request.
+func readSubscriptions(conn *websocket.Conn, bus *repo.SignalBus, ch chan domain.Signal, topics *[]string, done chan struct{}) {
+	defer close(done)
+	for {
+		var frame subscribeFrame
+		if err := conn.ReadJSON(&frame); err != nil {
+			return
+		}
+		if frame.Action != "subscribe" {
+			continue
+		}
+		newTopics := topicsFor(frame.Symbols, frame.Timeframes)
+		bus.SetTopics(ch, *topics, newTopics)
+		*topics = newTopics
+	}
+}
+
+func serveSSE(c *gin.Context, bus *repo.SignalBus) {
+	symbols := c.QueryArray("symbols")
+	timeframes := c.QueryArray("timeframes")
+	topics := topicsFor(symbols, timeframes)
+
+	ch, unsubscribe := bus.Subscribe(topics)
+	defer unsubscribe()
+
+	c.Writer.Header().Set("Content-Type", "text/event-stream")
+	c.Writer.Header().Set("Cache-Control", "no-cache")
+	c.Writer.Header().Set("Connection", "keep-alive")
+
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case sig, ok := <-ch:
+			if !ok {
+				return false
+			}
+			payload, err := json.Marshal(sig)
+			if err != nil {
+				return true
+			}
+			fmt.Fprintf(w, "data: %s\n\n", payload)
+			return true
+		case <-ticker.C:
+			fmt.Fprint(w, ": heartbeat\n\n")
+			return true
+		case <-c.Request.Context().Done():
+			return false
+		}
+	})
+}
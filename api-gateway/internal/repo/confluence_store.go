@@ -0,0 +1,57 @@
+package repo
+
+import (
+	"sync"
+
+	"apigateway/internal/domain"
+)
+
+// ConfluenceStore persists detected confluence events so they can be queried
+// after the fact instead of only ever reaching a log line and a Telegram
+// message. It follows the same interface-first shape as Store and
+// OutcomeStore so a durable backend can be added the same way SQLiteStore and
+// PostgresStore were added for signals.
+type ConfluenceStore interface {
+	// Save appends a confluence event; it never overwrites a previous one.
+	Save(c domain.ConfluenceSignal) error
+
+	// ForSymbol returns confluence events for symbol, newest first, capped at limit.
+	ForSymbol(symbol string, limit int) ([]domain.ConfluenceSignal, error)
+}
+
+// MemoryConfluenceStore is the in-process ConfluenceStore implementation,
+// kept only for the lifetime of the process.
+type MemoryConfluenceStore struct {
+	mu   sync.RWMutex
+	data map[string][]domain.ConfluenceSignal // symbol -> detected_at ascending
+}
+
+func NewMemoryConfluenceStore() *MemoryConfluenceStore {
+	return &MemoryConfluenceStore{data: make(map[string][]domain.ConfluenceSignal)}
+}
+
+var _ ConfluenceStore = (*MemoryConfluenceStore)(nil)
+
+// Save appends a confluence event; it never overwrites a previous one.
+func (s *MemoryConfluenceStore) Save(c domain.ConfluenceSignal) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data[c.Symbol] = append(s.data[c.Symbol], c)
+	return nil
+}
+
+// ForSymbol returns confluence events for symbol, newest first, capped at limit.
+func (s *MemoryConfluenceStore) ForSymbol(symbol string, limit int) ([]domain.ConfluenceSignal, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rows := s.data[symbol]
+	var results []domain.ConfluenceSignal
+	for i := len(rows) - 1; i >= 0; i-- {
+		results = append(results, rows[i])
+		if limit > 0 && len(results) >= limit {
+			break
+		}
+	}
+	return results, nil
+}
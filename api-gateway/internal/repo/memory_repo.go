@@ -1,35 +1,96 @@
 package repo
 
 import (
+	"sort"
 	"sync"
+	"time"
 
 	"apigateway/internal/domain"
 )
 
+// MemoryStore is the in-process Store implementation: an append-only log per
+// symbol/timeframe/exchange, kept only for the lifetime of the process.
+// SQLiteStore and PostgresStore exist for history that must survive restarts.
 type MemoryStore struct {
 	mu   sync.RWMutex
-	data map[string]domain.Signal
+	data map[string][]domain.Signal // key -> emitted_at ascending
 }
 
 func NewMemoryStore() *MemoryStore {
 	return &MemoryStore{
-		data: make(map[string]domain.Signal),
+		data: make(map[string][]domain.Signal),
 	}
 }
 
-// Save stores a signal using symbol+timeframe as key
-func (s *MemoryStore) Save(sig domain.Signal) {
+var _ Store = (*MemoryStore)(nil)
+
+func key(symbol, timeframe, exchange string) string {
+	return symbol + "_" + timeframe + "_" + exchange
+}
+
+// Save appends a signal; it never overwrites a previous one.
+func (s *MemoryStore) Save(sig domain.Signal) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	key := sig.Symbol + "_" + sig.Timeframe
-	s.data[key] = sig
+	k := key(sig.Symbol, sig.Timeframe, sig.Exchange)
+	s.data[k] = append(s.data[k], sig)
+	return nil
+}
+
+// Latest retrieves the most recently emitted signal for symbol/timeframe/exchange.
+func (s *MemoryStore) Latest(symbol, timeframe, exchange string) (domain.Signal, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	rows := s.data[key(symbol, timeframe, exchange)]
+	if len(rows) == 0 {
+		return domain.Signal{}, false, nil
+	}
+	return rows[len(rows)-1], true, nil
 }
 
-// Get retrieves the last signal
-func (s *MemoryStore) Get(symbol, timeframe string) (domain.Signal, bool) {
+// History returns signals for symbol/timeframe/exchange emitted within
+// [from, to], newest first, capped at limit.
+func (s *MemoryStore) History(symbol, timeframe, exchange string, from, to time.Time, limit int) ([]domain.Signal, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
-	key := symbol + "_" + timeframe
-	sig, ok := s.data[key]
-	return sig, ok
+
+	rows := s.data[key(symbol, timeframe, exchange)]
+	var results []domain.Signal
+	for i := len(rows) - 1; i >= 0; i-- {
+		sig := rows[i]
+		if !from.IsZero() && sig.EmittedAt.Before(from) {
+			continue
+		}
+		if !to.IsZero() && sig.EmittedAt.After(to) {
+			continue
+		}
+		results = append(results, sig)
+		if limit > 0 && len(results) >= limit {
+			break
+		}
+	}
+	return results, nil
+}
+
+// Query returns signals across all symbols matching filter, newest first.
+func (s *MemoryStore) Query(filter SignalFilter) ([]domain.Signal, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var results []domain.Signal
+	for _, rows := range s.data {
+		for _, sig := range rows {
+			if filter.Side != "" && sig.Side != filter.Side {
+				continue
+			}
+			if filter.MinRR > 0 && sig.RiskReward() < filter.MinRR {
+				continue
+			}
+			results = append(results, sig)
+		}
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].EmittedAt.After(results[j].EmittedAt)
+	})
+	return results, nil
 }
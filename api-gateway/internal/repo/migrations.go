@@ -0,0 +1,50 @@
+package repo
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// migration is a single forward-only schema change, applied once and
+// recorded in schema_migrations so restarts don't re-run it.
+type migration struct {
+	version int
+	sql     string
+}
+
+// runMigrations applies any migration not yet recorded in schema_migrations,
+// in version order. insertVersionSQL carries the driver-specific placeholder
+// style ("?" for SQLite, "$1" for Postgres).
+func runMigrations(db *sql.DB, migrations []migration, insertVersionSQL string) error {
+	if _, err := db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (version INTEGER PRIMARY KEY)`); err != nil {
+		return fmt.Errorf("create schema_migrations: %w", err)
+	}
+
+	applied := make(map[int]bool)
+	rows, err := db.Query(`SELECT version FROM schema_migrations`)
+	if err != nil {
+		return fmt.Errorf("read schema_migrations: %w", err)
+	}
+	for rows.Next() {
+		var v int
+		if err := rows.Scan(&v); err != nil {
+			rows.Close()
+			return err
+		}
+		applied[v] = true
+	}
+	rows.Close()
+
+	for _, m := range migrations {
+		if applied[m.version] {
+			continue
+		}
+		if _, err := db.Exec(m.sql); err != nil {
+			return fmt.Errorf("apply migration %d: %w", m.version, err)
+		}
+		if _, err := db.Exec(insertVersionSQL, m.version); err != nil {
+			return fmt.Errorf("record migration %d: %w", m.version, err)
+		}
+	}
+	return nil
+}
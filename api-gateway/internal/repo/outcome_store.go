@@ -0,0 +1,71 @@
+package repo
+
+import (
+	"sync"
+	"time"
+
+	"apigateway/internal/domain"
+)
+
+// OutcomeStore persists how each tracked Signal resolved. MemoryOutcomeStore
+// is the only implementation so far; it follows the same interface-first
+// shape as Store so a durable backend can be added the same way SQLiteStore
+// and PostgresStore were added for signals.
+type OutcomeStore interface {
+	// Save upserts an outcome, keyed by its signal ID.
+	Save(o domain.Outcome) error
+
+	// Open returns every outcome that hasn't reached a terminal state.
+	Open() ([]domain.Outcome, error)
+
+	// ForSymbol returns outcomes for symbol/timeframe resolved at or after since.
+	ForSymbol(symbol, timeframe string, since time.Time) ([]domain.Outcome, error)
+}
+
+type MemoryOutcomeStore struct {
+	mu   sync.Mutex
+	byID map[string]domain.Outcome
+}
+
+func NewMemoryOutcomeStore() *MemoryOutcomeStore {
+	return &MemoryOutcomeStore{byID: make(map[string]domain.Outcome)}
+}
+
+var _ OutcomeStore = (*MemoryOutcomeStore)(nil)
+
+func (s *MemoryOutcomeStore) Save(o domain.Outcome) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.byID[o.Signal.ID] = o
+	return nil
+}
+
+func (s *MemoryOutcomeStore) Open() ([]domain.Outcome, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var open []domain.Outcome
+	for _, o := range s.byID {
+		if !o.Resolved() {
+			open = append(open, o)
+		}
+	}
+	return open, nil
+}
+
+func (s *MemoryOutcomeStore) ForSymbol(symbol, timeframe string, since time.Time) ([]domain.Outcome, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var results []domain.Outcome
+	for _, o := range s.byID {
+		if o.Signal.Symbol != symbol || o.Signal.Timeframe != timeframe {
+			continue
+		}
+		if !o.Resolved() || o.ResolvedAt.Before(since) {
+			continue
+		}
+		results = append(results, o)
+	}
+	return results, nil
+}
@@ -0,0 +1,146 @@
+package repo
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"apigateway/internal/domain"
+
+	_ "github.com/lib/pq"
+)
+
+var postgresMigrations = []migration{
+	{version: 1, sql: `
+CREATE TABLE IF NOT EXISTS signals (
+	id SERIAL PRIMARY KEY,
+	symbol TEXT NOT NULL,
+	timeframe TEXT NOT NULL,
+	side TEXT NOT NULL,
+	entry_low DOUBLE PRECISION NOT NULL,
+	entry_high DOUBLE PRECISION NOT NULL,
+	stop_loss DOUBLE PRECISION NOT NULL,
+	take_profit DOUBLE PRECISION NOT NULL,
+	emitted_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_signals_symbol_tf_emitted ON signals(symbol, timeframe, emitted_at);
+`},
+	{version: 2, sql: `
+ALTER TABLE signals ADD COLUMN IF NOT EXISTS exchange TEXT NOT NULL DEFAULT 'binance';
+CREATE INDEX IF NOT EXISTS idx_signals_symbol_tf_exchange ON signals(symbol, timeframe, exchange, emitted_at);
+`},
+	{version: 3, sql: `
+ALTER TABLE signals ADD COLUMN IF NOT EXISTS signal_id TEXT NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS idx_signals_signal_id ON signals(signal_id);
+`},
+}
+
+// PostgresStore is a Store backed by Postgres, for multi-instance deployments
+// where several gateway replicas need to share signal history.
+type PostgresStore struct {
+	db *sql.DB
+}
+
+func NewPostgresStore(dsn string) (*PostgresStore, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, err
+	}
+	if err := runMigrations(db, postgresMigrations, `INSERT INTO schema_migrations (version) VALUES ($1)`); err != nil {
+		return nil, err
+	}
+	return &PostgresStore{db: db}, nil
+}
+
+var _ Store = (*PostgresStore)(nil)
+
+func (s *PostgresStore) Save(sig domain.Signal) error {
+	_, err := s.db.Exec(
+		`INSERT INTO signals (signal_id, symbol, timeframe, exchange, side, entry_low, entry_high, stop_loss, take_profit, emitted_at)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		sig.ID, sig.Symbol, sig.Timeframe, sig.Exchange, sig.Side, sig.EntryLow, sig.EntryHigh, sig.StopLoss, sig.TakeProfit, sig.EmittedAt,
+	)
+	return err
+}
+
+func (s *PostgresStore) Latest(symbol, timeframe, exchange string) (domain.Signal, bool, error) {
+	row := s.db.QueryRow(
+		`SELECT signal_id, symbol, timeframe, exchange, side, entry_low, entry_high, stop_loss, take_profit, emitted_at
+		 FROM signals WHERE symbol = $1 AND timeframe = $2 AND exchange = $3 ORDER BY emitted_at DESC LIMIT 1`,
+		symbol, timeframe, exchange,
+	)
+	sig, err := scanSignal(row)
+	if err == sql.ErrNoRows {
+		return domain.Signal{}, false, nil
+	}
+	if err != nil {
+		return domain.Signal{}, false, err
+	}
+	return sig, true, nil
+}
+
+func (s *PostgresStore) History(symbol, timeframe, exchange string, from, to time.Time, limit int) ([]domain.Signal, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+
+	query := `SELECT signal_id, symbol, timeframe, exchange, side, entry_low, entry_high, stop_loss, take_profit, emitted_at
+		FROM signals WHERE symbol = $1 AND timeframe = $2 AND exchange = $3`
+	args := []interface{}{symbol, timeframe, exchange}
+
+	if !from.IsZero() {
+		args = append(args, from)
+		query += fmt.Sprintf(" AND emitted_at >= $%d", len(args))
+	}
+	if !to.IsZero() {
+		args = append(args, to)
+		query += fmt.Sprintf(" AND emitted_at <= $%d", len(args))
+	}
+	args = append(args, limit)
+	query += fmt.Sprintf(" ORDER BY emitted_at DESC LIMIT $%d", len(args))
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []domain.Signal
+	for rows.Next() {
+		sig, err := scanSignal(rows)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, sig)
+	}
+	return results, rows.Err()
+}
+
+func (s *PostgresStore) Query(filter SignalFilter) ([]domain.Signal, error) {
+	query := `SELECT signal_id, symbol, timeframe, exchange, side, entry_low, entry_high, stop_loss, take_profit, emitted_at FROM signals`
+	var args []interface{}
+	if filter.Side != "" {
+		args = append(args, filter.Side)
+		query += fmt.Sprintf(" WHERE side = $%d", len(args))
+	}
+	query += ` ORDER BY emitted_at DESC`
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []domain.Signal
+	for rows.Next() {
+		sig, err := scanSignal(rows)
+		if err != nil {
+			return nil, err
+		}
+		if filter.MinRR > 0 && sig.RiskReward() < filter.MinRR {
+			continue
+		}
+		results = append(results, sig)
+	}
+	return results, rows.Err()
+}
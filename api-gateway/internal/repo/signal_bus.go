@@ -0,0 +1,92 @@
+package repo
+
+import (
+	"sync"
+
+	"apigateway/internal/domain"
+)
+
+// busBufferSize bounds each subscriber's channel buffer. A subscriber has one
+// buffer shared by all of its topics, not one per topic. Once full, Publish
+// drops the new message for that subscriber instead of blocking the scanner
+// on a slow consumer; already-buffered messages are kept.
+const busBufferSize = 32
+
+// SignalBus is an in-process pub/sub fan-out for live signals, feeding the
+// websocket and SSE streaming endpoints. Scanner.scanOne() publishes here in
+// addition to saving into Store.
+type SignalBus struct {
+	mu   sync.RWMutex
+	subs map[string]map[chan domain.Signal]struct{}
+}
+
+func NewSignalBus() *SignalBus {
+	return &SignalBus{
+		subs: make(map[string]map[chan domain.Signal]struct{}),
+	}
+}
+
+// Topic builds the bus key for a symbol/timeframe pair.
+func Topic(symbol, timeframe string) string {
+	return symbol + "_" + timeframe
+}
+
+// Publish fans a signal out to every subscriber on its topic.
+func (b *SignalBus) Publish(sig domain.Signal) {
+	topic := Topic(sig.Symbol, sig.Timeframe)
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for ch := range b.subs[topic] {
+		select {
+		case ch <- sig:
+		default:
+			// Slow consumer; drop rather than block the publisher.
+		}
+	}
+}
+
+// Subscribe registers a new consumer on the given topics and returns its
+// channel plus an unsubscribe function that must be called on disconnect.
+func (b *SignalBus) Subscribe(topics []string) (chan domain.Signal, func()) {
+	ch := make(chan domain.Signal, busBufferSize)
+
+	b.mu.Lock()
+	for _, t := range topics {
+		b.addLocked(t, ch)
+	}
+	b.mu.Unlock()
+
+	unsubscribe := func() {
+		b.mu.Lock()
+		// Remove ch from every topic, including any added later by SetTopics.
+		for _, set := range b.subs {
+			delete(set, ch)
+		}
+		b.mu.Unlock()
+		close(ch)
+	}
+	return ch, unsubscribe
+}
+
+// SetTopics replaces an existing subscriber's topic set, used when a
+// connection sends a new subscription frame mid-stream.
+func (b *SignalBus) SetTopics(ch chan domain.Signal, oldTopics, newTopics []string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, t := range oldTopics {
+		delete(b.subs[t], ch)
+	}
+	for _, t := range newTopics {
+		b.addLocked(t, ch)
+	}
+}
+
+func (b *SignalBus) addLocked(topic string, ch chan domain.Signal) {
+	if b.subs[topic] == nil {
+		b.subs[topic] = make(map[chan domain.Signal]struct{})
+	}
+	b.subs[topic][ch] = struct{}{}
+}
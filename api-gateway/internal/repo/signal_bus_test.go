@@ -0,0 +1,75 @@
+package repo
+
+import (
+	"testing"
+	"time"
+
+	"apigateway/internal/domain"
+)
+
+func TestSignalBusPublishDeliversToMatchingTopic(t *testing.T) {
+	bus := NewSignalBus()
+	ch, unsubscribe := bus.Subscribe([]string{Topic("BTCUSDT", "15m")})
+	defer unsubscribe()
+
+	bus.Publish(domain.Signal{Symbol: "BTCUSDT", Timeframe: "15m"})
+	bus.Publish(domain.Signal{Symbol: "ETHUSDT", Timeframe: "15m"})
+
+	select {
+	case sig := <-ch:
+		if sig.Symbol != "BTCUSDT" {
+			t.Fatalf("got signal for %s, want BTCUSDT", sig.Symbol)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected a signal on the subscribed topic")
+	}
+
+	select {
+	case sig := <-ch:
+		t.Fatalf("got unexpected second signal %+v, ETHUSDT shouldn't match this topic", sig)
+	default:
+	}
+}
+
+func TestSignalBusPublishDropsOnFullBuffer(t *testing.T) {
+	bus := NewSignalBus()
+	ch, unsubscribe := bus.Subscribe([]string{Topic("BTCUSDT", "15m")})
+	defer unsubscribe()
+
+	for i := 0; i < busBufferSize+5; i++ {
+		bus.Publish(domain.Signal{Symbol: "BTCUSDT", Timeframe: "15m"})
+	}
+
+	count := 0
+	for {
+		select {
+		case <-ch:
+			count++
+		default:
+			if count != busBufferSize {
+				t.Fatalf("got %d buffered signals, want %d (excess should have been dropped)", count, busBufferSize)
+			}
+			return
+		}
+	}
+}
+
+func TestSignalBusSetTopicsMovesSubscription(t *testing.T) {
+	bus := NewSignalBus()
+	ch, unsubscribe := bus.Subscribe([]string{Topic("BTCUSDT", "15m")})
+	defer unsubscribe()
+
+	bus.SetTopics(ch, []string{Topic("BTCUSDT", "15m")}, []string{Topic("ETHUSDT", "1h")})
+
+	bus.Publish(domain.Signal{Symbol: "BTCUSDT", Timeframe: "15m"})
+	bus.Publish(domain.Signal{Symbol: "ETHUSDT", Timeframe: "1h"})
+
+	select {
+	case sig := <-ch:
+		if sig.Symbol != "ETHUSDT" {
+			t.Fatalf("got signal for %s, want ETHUSDT after SetTopics", sig.Symbol)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected a signal on the new topic")
+	}
+}
@@ -0,0 +1,161 @@
+package repo
+
+import (
+	"database/sql"
+	"time"
+
+	"apigateway/internal/domain"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+var sqliteMigrations = []migration{
+	{version: 1, sql: `
+CREATE TABLE IF NOT EXISTS signals (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	symbol TEXT NOT NULL,
+	timeframe TEXT NOT NULL,
+	side TEXT NOT NULL,
+	entry_low REAL NOT NULL,
+	entry_high REAL NOT NULL,
+	stop_loss REAL NOT NULL,
+	take_profit REAL NOT NULL,
+	emitted_at DATETIME NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_signals_symbol_tf_emitted ON signals(symbol, timeframe, emitted_at);
+`},
+	{version: 2, sql: `
+ALTER TABLE signals ADD COLUMN exchange TEXT NOT NULL DEFAULT 'binance';
+CREATE INDEX IF NOT EXISTS idx_signals_symbol_tf_exchange ON signals(symbol, timeframe, exchange, emitted_at);
+`},
+	{version: 3, sql: `
+ALTER TABLE signals ADD COLUMN signal_id TEXT NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS idx_signals_signal_id ON signals(signal_id);
+`},
+}
+
+// SQLiteStore is a Store backed by an on-disk SQLite database, for
+// single-instance deployments that still want signal history to survive
+// restarts without running a separate Postgres server.
+type SQLiteStore struct {
+	db *sql.DB
+}
+
+func NewSQLiteStore(path string) (*SQLiteStore, error) {
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := runMigrations(db, sqliteMigrations, `INSERT INTO schema_migrations (version) VALUES (?)`); err != nil {
+		return nil, err
+	}
+	return &SQLiteStore{db: db}, nil
+}
+
+var _ Store = (*SQLiteStore)(nil)
+
+func (s *SQLiteStore) Save(sig domain.Signal) error {
+	_, err := s.db.Exec(
+		`INSERT INTO signals (signal_id, symbol, timeframe, exchange, side, entry_low, entry_high, stop_loss, take_profit, emitted_at)
+		 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		sig.ID, sig.Symbol, sig.Timeframe, sig.Exchange, sig.Side, sig.EntryLow, sig.EntryHigh, sig.StopLoss, sig.TakeProfit, sig.EmittedAt,
+	)
+	return err
+}
+
+func (s *SQLiteStore) Latest(symbol, timeframe, exchange string) (domain.Signal, bool, error) {
+	row := s.db.QueryRow(
+		`SELECT signal_id, symbol, timeframe, exchange, side, entry_low, entry_high, stop_loss, take_profit, emitted_at
+		 FROM signals WHERE symbol = ? AND timeframe = ? AND exchange = ? ORDER BY emitted_at DESC LIMIT 1`,
+		symbol, timeframe, exchange,
+	)
+	sig, err := scanSignal(row)
+	if err == sql.ErrNoRows {
+		return domain.Signal{}, false, nil
+	}
+	if err != nil {
+		return domain.Signal{}, false, err
+	}
+	return sig, true, nil
+}
+
+func (s *SQLiteStore) History(symbol, timeframe, exchange string, from, to time.Time, limit int) ([]domain.Signal, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+
+	query := `SELECT signal_id, symbol, timeframe, exchange, side, entry_low, entry_high, stop_loss, take_profit, emitted_at
+		FROM signals WHERE symbol = ? AND timeframe = ? AND exchange = ?`
+	args := []interface{}{symbol, timeframe, exchange}
+
+	if !from.IsZero() {
+		query += ` AND emitted_at >= ?`
+		args = append(args, from)
+	}
+	if !to.IsZero() {
+		query += ` AND emitted_at <= ?`
+		args = append(args, to)
+	}
+	query += ` ORDER BY emitted_at DESC LIMIT ?`
+	args = append(args, limit)
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []domain.Signal
+	for rows.Next() {
+		sig, err := scanSignal(rows)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, sig)
+	}
+	return results, rows.Err()
+}
+
+func (s *SQLiteStore) Query(filter SignalFilter) ([]domain.Signal, error) {
+	query := `SELECT signal_id, symbol, timeframe, exchange, side, entry_low, entry_high, stop_loss, take_profit, emitted_at FROM signals`
+	var args []interface{}
+	if filter.Side != "" {
+		query += ` WHERE side = ?`
+		args = append(args, filter.Side)
+	}
+	query += ` ORDER BY emitted_at DESC`
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []domain.Signal
+	for rows.Next() {
+		sig, err := scanSignal(rows)
+		if err != nil {
+			return nil, err
+		}
+		if filter.MinRR > 0 && sig.RiskReward() < filter.MinRR {
+			continue
+		}
+		results = append(results, sig)
+	}
+	return results, rows.Err()
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanSignal(row rowScanner) (domain.Signal, error) {
+	var sig domain.Signal
+	err := row.Scan(
+		&sig.ID, &sig.Symbol, &sig.Timeframe, &sig.Exchange, &sig.Side,
+		&sig.EntryLow, &sig.EntryHigh, &sig.StopLoss, &sig.TakeProfit,
+		&sig.EmittedAt,
+	)
+	return sig, err
+}
@@ -0,0 +1,32 @@
+package repo
+
+import (
+	"time"
+
+	"apigateway/internal/domain"
+)
+
+// SignalFilter narrows a cross-symbol Query.
+type SignalFilter struct {
+	Side  string // "" matches any
+	MinRR float64
+}
+
+// Store is the persistence contract for emitted signals. Every Save appends
+// an immutable row keyed by (symbol, timeframe, exchange, emitted_at);
+// nothing is overwritten, which is what lets History and Query serve past
+// signals. MemoryStore, SQLiteStore and PostgresStore all implement it.
+type Store interface {
+	// Save persists a new signal. It never overwrites a previous one.
+	Save(sig domain.Signal) error
+
+	// Latest returns the most recently emitted signal for symbol/timeframe/exchange.
+	Latest(symbol, timeframe, exchange string) (domain.Signal, bool, error)
+
+	// History returns signals for symbol/timeframe/exchange emitted within
+	// [from, to], newest first, capped at limit.
+	History(symbol, timeframe, exchange string, from, to time.Time, limit int) ([]domain.Signal, error)
+
+	// Query returns signals across all symbols matching filter, newest first.
+	Query(filter SignalFilter) ([]domain.Signal, error)
+}
@@ -0,0 +1,32 @@
+package repo
+
+import (
+	"fmt"
+	"os"
+)
+
+// NewStoreFromEnv selects a Store backend based on the STORE_BACKEND env var
+// ("memory", "sqlite", or "postgres"), defaulting to MemoryStore so
+// deployments that don't set it keep the original in-process behavior.
+// SQLite reads its database path from SQLITE_PATH (default "signals.db");
+// Postgres reads its connection string from POSTGRES_DSN (required).
+func NewStoreFromEnv() (Store, error) {
+	switch backend := os.Getenv("STORE_BACKEND"); backend {
+	case "", "memory":
+		return NewMemoryStore(), nil
+	case "sqlite":
+		path := os.Getenv("SQLITE_PATH")
+		if path == "" {
+			path = "signals.db"
+		}
+		return NewSQLiteStore(path)
+	case "postgres":
+		dsn := os.Getenv("POSTGRES_DSN")
+		if dsn == "" {
+			return nil, fmt.Errorf("STORE_BACKEND=postgres requires POSTGRES_DSN")
+		}
+		return NewPostgresStore(dsn)
+	default:
+		return nil, fmt.Errorf("unknown STORE_BACKEND %q (want memory, sqlite, or postgres)", backend)
+	}
+}
@@ -0,0 +1,99 @@
+package repo
+
+import (
+	"sync"
+	"time"
+)
+
+// SubscriptionFilter identifies a symbol/timeframe pair a chat wants signals for.
+type SubscriptionFilter struct {
+	Symbol    string
+	Timeframe string
+}
+
+// SubscriptionStore tracks which chats opted into which symbol/timeframe
+// filters, and which chats are currently muted. It replaces the single
+// hardcoded ChatID previously wired into Scanner.
+type SubscriptionStore struct {
+	mu        sync.RWMutex
+	subs      map[string]map[SubscriptionFilter]struct{}
+	mutedUntil map[string]time.Time
+}
+
+func NewSubscriptionStore() *SubscriptionStore {
+	return &SubscriptionStore{
+		subs:       make(map[string]map[SubscriptionFilter]struct{}),
+		mutedUntil: make(map[string]time.Time),
+	}
+}
+
+// Subscribe opts a chat into a symbol/timeframe filter.
+func (s *SubscriptionStore) Subscribe(chatID, symbol, timeframe string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.subs[chatID] == nil {
+		s.subs[chatID] = make(map[SubscriptionFilter]struct{})
+	}
+	s.subs[chatID][SubscriptionFilter{Symbol: symbol, Timeframe: timeframe}] = struct{}{}
+}
+
+// Unsubscribe removes a filter for a chat. An empty symbol clears every filter for the chat.
+func (s *SubscriptionStore) Unsubscribe(chatID, symbol, timeframe string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if symbol == "" {
+		delete(s.subs, chatID)
+		return
+	}
+	delete(s.subs[chatID], SubscriptionFilter{Symbol: symbol, Timeframe: timeframe})
+}
+
+// List returns the filters a chat is currently subscribed to.
+func (s *SubscriptionStore) List(chatID string) []SubscriptionFilter {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	filters := make([]SubscriptionFilter, 0, len(s.subs[chatID]))
+	for f := range s.subs[chatID] {
+		filters = append(filters, f)
+	}
+	return filters
+}
+
+// ChatsFor returns every chat subscribed to the given symbol/timeframe and not currently muted.
+func (s *SubscriptionStore) ChatsFor(symbol, timeframe string) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	filter := SubscriptionFilter{Symbol: symbol, Timeframe: timeframe}
+	now := time.Now()
+	var chats []string
+	for chatID, filters := range s.subs {
+		if _, ok := filters[filter]; !ok {
+			continue
+		}
+		if until, muted := s.mutedUntil[chatID]; muted && now.Before(until) {
+			continue
+		}
+		chats = append(chats, chatID)
+	}
+	return chats
+}
+
+// Mute silences chatID until until; notifications for its subscriptions are
+// skipped by ChatsFor in the meantime.
+func (s *SubscriptionStore) Mute(chatID string, until time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.mutedUntil[chatID] = until
+}
+
+// IsMuted reports whether chatID is within its /mute window.
+func (s *SubscriptionStore) IsMuted(chatID string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	until, ok := s.mutedUntil[chatID]
+	return ok && time.Now().Before(until)
+}
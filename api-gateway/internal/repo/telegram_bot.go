@@ -0,0 +1,292 @@
+package repo
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// sessionState models the per-chat conversational flow for `/subscribe` when
+// it's invoked without arguments (idle -> awaiting-symbol -> awaiting-timeframe).
+type sessionState int
+
+const (
+	stateIdle sessionState = iota
+	stateAwaitingSymbol
+	stateAwaitingTimeframe
+)
+
+// defaultSignalTimeframe/defaultSignalExchange are used by /signal when the
+// user doesn't name one, matching the defaults the HTTP handlers fall back
+// to (kept separate to avoid an import cycle with the handler package).
+const (
+	defaultSignalTimeframe = "15m"
+	defaultSignalExchange  = "binance"
+)
+
+type session struct {
+	state  sessionState
+	symbol string
+}
+
+// Bot drives a long-polling dispatcher over the Telegram Bot API, authorizing
+// chats via a shared `/start <token>` handshake and routing subscription
+// commands into a SubscriptionStore. It sits on top of Client, which remains
+// the low-level message sender.
+type Bot struct {
+	client *Client
+	subs   *SubscriptionStore
+	store  Store
+	token  string // shared handshake token expected by /start
+
+	mu       sync.Mutex
+	sessions map[string]*session
+	allowed  map[string]bool // chat_id -> authorized
+
+	offset int
+}
+
+func NewBot(client *Client, subs *SubscriptionStore, store Store, startToken string) *Bot {
+	return &Bot{
+		client:   client,
+		subs:     subs,
+		store:    store,
+		token:    startToken,
+		sessions: make(map[string]*session),
+		allowed:  make(map[string]bool),
+	}
+}
+
+// Start begins long-polling getUpdates in the background.
+func (b *Bot) Start() {
+	go func() {
+		for {
+			updates, err := b.getUpdates()
+			if err != nil {
+				log.Printf("telegram: getUpdates failed: %v", err)
+				time.Sleep(3 * time.Second)
+				continue
+			}
+			for _, u := range updates {
+				b.offset = u.UpdateID + 1
+				if u.Message.Text == "" {
+					continue
+				}
+				b.dispatch(strconv.FormatInt(u.Message.Chat.ID, 10), u.Message.Text)
+			}
+		}
+	}()
+}
+
+func (b *Bot) sessionFor(chatID string) *session {
+	sess, ok := b.sessions[chatID]
+	if !ok {
+		sess = &session{state: stateIdle}
+		b.sessions[chatID] = sess
+	}
+	return sess
+}
+
+func (b *Bot) dispatch(chatID, text string) {
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		return
+	}
+	cmd := strings.ToLower(fields[0])
+	args := fields[1:]
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	sess := b.sessionFor(chatID)
+
+	// Commands other than /start require prior authorization.
+	if cmd != "/start" && !b.allowed[chatID] {
+		b.reply(chatID, "🔒 This chat isn't authorized yet. Send /start <token> first.")
+		return
+	}
+
+	// Mid-flow free text feeds the conversational state machine.
+	if sess.state != stateIdle && !strings.HasPrefix(cmd, "/") {
+		b.continueFlow(chatID, sess, text)
+		return
+	}
+
+	switch cmd {
+	case "/start":
+		b.handleStart(chatID, args)
+	case "/subscribe":
+		b.handleSubscribe(chatID, sess, args)
+	case "/unsubscribe":
+		b.handleUnsubscribe(chatID, args)
+	case "/list":
+		b.handleList(chatID)
+	case "/signal":
+		b.handleSignal(chatID, args)
+	case "/mute":
+		b.handleMute(chatID, args)
+	default:
+		b.reply(chatID, "Unknown command. Try /subscribe, /unsubscribe, /list, /signal or /mute.")
+	}
+}
+
+func (b *Bot) handleStart(chatID string, args []string) {
+	if len(args) != 1 || args[0] != b.token {
+		b.reply(chatID, "Send /start <token> with the token you were given to authorize this chat.")
+		return
+	}
+	b.allowed[chatID] = true
+	b.reply(chatID, "✅ Chat authorized. Use /subscribe SYMBOL TIMEFRAME to start receiving signals.")
+}
+
+func (b *Bot) handleSubscribe(chatID string, sess *session, args []string) {
+	if len(args) >= 2 {
+		b.subs.Subscribe(chatID, strings.ToUpper(args[0]), args[1])
+		b.reply(chatID, fmt.Sprintf("🔔 Subscribed to %s %s", strings.ToUpper(args[0]), args[1]))
+		return
+	}
+	if len(args) == 1 {
+		sess.state = stateAwaitingTimeframe
+		sess.symbol = strings.ToUpper(args[0])
+		b.reply(chatID, fmt.Sprintf("Which timeframe for %s? (e.g. 15m, 1h, 4h)", sess.symbol))
+		return
+	}
+	// No args: walk the user through it conversationally.
+	sess.state = stateAwaitingSymbol
+	b.reply(chatID, "Which symbol do you want to subscribe to? (e.g. BTCUSDT)")
+}
+
+func (b *Bot) continueFlow(chatID string, sess *session, text string) {
+	switch sess.state {
+	case stateAwaitingSymbol:
+		sess.symbol = strings.ToUpper(strings.TrimSpace(text))
+		sess.state = stateAwaitingTimeframe
+		b.reply(chatID, fmt.Sprintf("Which timeframe for %s? (e.g. 15m, 1h, 4h)", sess.symbol))
+	case stateAwaitingTimeframe:
+		timeframe := strings.TrimSpace(text)
+		b.subs.Subscribe(chatID, sess.symbol, timeframe)
+		b.reply(chatID, fmt.Sprintf("🔔 Subscribed to %s %s", sess.symbol, timeframe))
+		sess.state = stateIdle
+		sess.symbol = ""
+	default:
+		sess.state = stateIdle
+	}
+}
+
+func (b *Bot) handleUnsubscribe(chatID string, args []string) {
+	switch len(args) {
+	case 0:
+		b.subs.Unsubscribe(chatID, "", "")
+		b.reply(chatID, "🔕 Unsubscribed from everything.")
+	case 1:
+		b.reply(chatID, "Usage: /unsubscribe SYMBOL TIMEFRAME")
+	default:
+		b.subs.Unsubscribe(chatID, strings.ToUpper(args[0]), args[1])
+		b.reply(chatID, fmt.Sprintf("🔕 Unsubscribed from %s %s", strings.ToUpper(args[0]), args[1]))
+	}
+}
+
+func (b *Bot) handleList(chatID string) {
+	filters := b.subs.List(chatID)
+	if len(filters) == 0 {
+		b.reply(chatID, "No active subscriptions.")
+		return
+	}
+	var lines []string
+	for _, f := range filters {
+		lines = append(lines, fmt.Sprintf("• %s %s", f.Symbol, f.Timeframe))
+	}
+	b.reply(chatID, strings.Join(lines, "\n"))
+}
+
+func (b *Bot) handleSignal(chatID string, args []string) {
+	if len(args) < 1 {
+		b.reply(chatID, "Usage: /signal SYMBOL [TIMEFRAME]")
+		return
+	}
+
+	symbol := strings.ToUpper(args[0])
+	timeframe := defaultSignalTimeframe
+	if len(args) >= 2 {
+		timeframe = args[1]
+	}
+
+	sig, ok, err := b.store.Latest(symbol, timeframe, defaultSignalExchange)
+	if err != nil {
+		b.reply(chatID, fmt.Sprintf("Couldn't fetch signal for %s %s: %v", symbol, timeframe, err))
+		return
+	}
+	if !ok {
+		b.reply(chatID, fmt.Sprintf("No signal yet for %s %s", symbol, timeframe))
+		return
+	}
+
+	b.reply(chatID, fmt.Sprintf(
+		"📊 %s %s (%s)\nSide: %s\nEntry: %.4f-%.4f\nSL: %.4f\nTP: %.4f",
+		sig.Symbol, sig.Timeframe, sig.Exchange, sig.Side, sig.EntryLow, sig.EntryHigh, sig.StopLoss, sig.TakeProfit,
+	))
+}
+
+func (b *Bot) handleMute(chatID string, args []string) {
+	if len(args) != 1 {
+		b.reply(chatID, "Usage: /mute 1h")
+		return
+	}
+	d, err := time.ParseDuration(args[0])
+	if err != nil {
+		b.reply(chatID, "Couldn't parse duration, try something like 30m or 1h.")
+		return
+	}
+	b.subs.Mute(chatID, time.Now().Add(d))
+	b.reply(chatID, fmt.Sprintf("🔇 Muted for %s", d))
+}
+
+func (b *Bot) reply(chatID, message string) {
+	if err := b.client.SendMessageTo(chatID, message); err != nil {
+		log.Printf("telegram: reply to %s failed: %v", chatID, err)
+	}
+}
+
+// --- Telegram getUpdates wire types ---
+
+type tgUpdate struct {
+	UpdateID int `json:"update_id"`
+	Message  struct {
+		Chat struct {
+			ID int64 `json:"id"`
+		} `json:"chat"`
+		Text string `json:"text"`
+	} `json:"message"`
+}
+
+type tgUpdatesResponse struct {
+	OK     bool       `json:"ok"`
+	Result []tgUpdate `json:"result"`
+}
+
+func (b *Bot) getUpdates() ([]tgUpdate, error) {
+	apiURL := fmt.Sprintf("https://api.telegram.org/bot%s/getUpdates?timeout=30&offset=%d", b.client.Token, b.offset)
+
+	resp, err := http.Get(apiURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var parsed tgUpdatesResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, err
+	}
+	return parsed.Result, nil
+}
@@ -16,9 +16,15 @@ func NewClient(token, chatID string) *Client {
 }
 
 func (c *Client) SendMessage(message string) error {
+	return c.SendMessageTo(c.ChatID, message)
+}
+
+// SendMessageTo sends a message to an arbitrary chat, used once chats are
+// tracked individually via subscriptions rather than the single ChatID.
+func (c *Client) SendMessageTo(chatID, message string) error {
 	apiURL := fmt.Sprintf("https://api.telegram.org/bot%s/sendMessage", c.Token)
 	resp, err := http.PostForm(apiURL, url.Values{
-		"chat_id": {c.ChatID},
+		"chat_id": {chatID},
 		"text":    {message},
 	})
 	if err != nil {
@@ -0,0 +1,84 @@
+package scheduler
+
+import (
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TimeframeConfig pairs a timeframe with how often it should be re-scanned.
+type TimeframeConfig struct {
+	Timeframe string        `yaml:"timeframe"`
+	Interval  time.Duration `yaml:"interval"`
+}
+
+// PairConfig is a symbol and the timeframes it should be scanned on.
+type PairConfig struct {
+	Symbol     string            `yaml:"symbol"`
+	Timeframes []TimeframeConfig `yaml:"timeframes"`
+}
+
+// ConfluenceConfig describes when per-timeframe signals for a symbol should
+// be rolled up into a domain.ConfluenceSignal.
+type ConfluenceConfig struct {
+	Timeframes []string      `yaml:"timeframes"` // must all agree on side
+	Staleness  time.Duration `yaml:"staleness"`   // max age of a contributing signal
+	Cooldown   time.Duration `yaml:"cooldown"`    // min gap between notifications for the same (symbol, side)
+}
+
+// Config is the scan matrix loaded from YAML, replacing the old hardcoded
+// "15m" + six pairs.
+type Config struct {
+	Pairs      []PairConfig     `yaml:"pairs"`
+	Exchanges  []string         `yaml:"exchanges"` // venues each pair is analyzed on, e.g. ["binance", "bybit"]
+	Confluence ConfluenceConfig `yaml:"confluence"`
+}
+
+// DefaultConfig keeps the six pairs and the 2-minute 15m scan the scanner
+// used to hardcode, and adds 1h and 4h scans so every default confluence
+// timeframe has signals. It is used when no SCANNER_CONFIG file is set.
+func DefaultConfig() Config {
+	pairs := []string{"BTCUSDT", "ETHUSDT", "BNBUSDT", "ZECUSDT", "XRPUSDT", "SOLUSDT"}
+	defaultTFs := []TimeframeConfig{
+		{Timeframe: "15m", Interval: 2 * time.Minute},
+		{Timeframe: "1h", Interval: 5 * time.Minute},
+		{Timeframe: "4h", Interval: 15 * time.Minute},
+	}
+
+	cfg := Config{
+		Exchanges: []string{"binance"},
+		Confluence: ConfluenceConfig{
+			Timeframes: []string{"15m", "1h", "4h"},
+			Staleness:  20 * time.Minute,
+			Cooldown:   30 * time.Minute,
+		},
+	}
+	for _, symbol := range pairs {
+		cfg.Pairs = append(cfg.Pairs, PairConfig{Symbol: symbol, Timeframes: defaultTFs})
+	}
+	return cfg
+}
+
+// LoadConfig reads the scan matrix from a YAML file at path, falling back to
+// the path in the SCANNER_CONFIG env var, and to DefaultConfig() if neither
+// is set.
+func LoadConfig(path string) (Config, error) {
+	if path == "" {
+		path = os.Getenv("SCANNER_CONFIG")
+	}
+	if path == "" {
+		return DefaultConfig(), nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
@@ -0,0 +1,52 @@
+package scheduler
+
+import (
+	"container/heap"
+	"time"
+)
+
+// scanTask is one (exchange, symbol, timeframe) slot in the scan matrix,
+// re-queued once each scan completes at next = completion time + interval.
+type scanTask struct {
+	exchange  string
+	symbol    string
+	timeframe string
+	interval  time.Duration
+	next      time.Time
+	index     int // maintained by heap.Interface
+}
+
+// taskQueue is a min-heap ordered by next fire time, replacing the single
+// 2-minute ticker so each timeframe can be scanned on its own cadence.
+type taskQueue []*scanTask
+
+func (q taskQueue) Len() int { return len(q) }
+
+func (q taskQueue) Less(i, j int) bool { return q[i].next.Before(q[j].next) }
+
+func (q taskQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *taskQueue) Push(x interface{}) {
+	task := x.(*scanTask)
+	task.index = len(*q)
+	*q = append(*q, task)
+}
+
+func (q *taskQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	task := old[n-1]
+	old[n-1] = nil
+	*q = old[:n-1]
+	return task
+}
+
+// reschedule re-queues task at its next interval-based fire time.
+func (q *taskQueue) reschedule(task *scanTask, now time.Time) {
+	task.next = now.Add(task.interval)
+	heap.Push(q, task)
+}
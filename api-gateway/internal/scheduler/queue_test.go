@@ -0,0 +1,45 @@
+package scheduler
+
+import (
+	"container/heap"
+	"testing"
+	"time"
+)
+
+func TestTaskQueuePopsEarliestFirst(t *testing.T) {
+	now := time.Now()
+	queue := &taskQueue{}
+	heap.Init(queue)
+
+	heap.Push(queue, &scanTask{symbol: "ETHUSDT", next: now.Add(3 * time.Minute)})
+	heap.Push(queue, &scanTask{symbol: "BTCUSDT", next: now.Add(1 * time.Minute)})
+	heap.Push(queue, &scanTask{symbol: "SOLUSDT", next: now.Add(2 * time.Minute)})
+
+	var order []string
+	for queue.Len() > 0 {
+		order = append(order, heap.Pop(queue).(*scanTask).symbol)
+	}
+
+	want := []string{"BTCUSDT", "SOLUSDT", "ETHUSDT"}
+	for i, sym := range want {
+		if order[i] != sym {
+			t.Fatalf("pop order = %v, want %v", order, want)
+		}
+	}
+}
+
+func TestTaskQueueRescheduleRequeuesAtInterval(t *testing.T) {
+	queue := &taskQueue{}
+	heap.Init(queue)
+
+	now := time.Now()
+	task := &scanTask{symbol: "BTCUSDT", interval: 5 * time.Minute}
+	queue.reschedule(task, now)
+
+	if queue.Len() != 1 {
+		t.Fatalf("queue.Len() = %d, want 1", queue.Len())
+	}
+	if !task.next.Equal(now.Add(5 * time.Minute)) {
+		t.Fatalf("task.next = %v, want %v", task.next, now.Add(5*time.Minute))
+	}
+}
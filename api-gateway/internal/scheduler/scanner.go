@@ -1,75 +1,271 @@
 package scheduler
 
 import (
+	"container/heap"
 	"fmt"
 	"log"
+	"sync"
 	"time"
 
 	"apigateway/internal/domain"
+	"apigateway/internal/exchange"
 	"apigateway/internal/grpc"
 	"apigateway/internal/repo"
 	telegram "apigateway/internal/repo"
+	"apigateway/internal/tracker"
 )
 
 type Scanner struct {
-	client   *grpc.SMCClient
-	store    *repo.MemoryStore
-	telegram *telegram.Client
-	pairs    []string
+	client     *grpc.SMCClient
+	store      repo.Store
+	confluence repo.ConfluenceStore
+	telegram   *telegram.Client
+	subs       *telegram.SubscriptionStore
+	bus        *repo.SignalBus
+	tracker    *tracker.Tracker
+	cfg        Config
+
+	mu         sync.Mutex
+	lastNotify map[string]time.Time // "symbol_side" -> last notification time, for cooldown
 }
 
-func NewScanner(client *grpc.SMCClient, store *repo.MemoryStore, tg *telegram.Client, pairs []string) *Scanner {
-	return &Scanner{client, store, tg, pairs}
+func NewScanner(client *grpc.SMCClient, store repo.Store, confluence repo.ConfluenceStore, tg *telegram.Client, subs *telegram.SubscriptionStore, bus *repo.SignalBus, trk *tracker.Tracker, cfg Config) *Scanner {
+	return &Scanner{
+		client:     client,
+		store:      store,
+		confluence: confluence,
+		telegram:   tg,
+		subs:       subs,
+		bus:        bus,
+		tracker:    trk,
+		cfg:        cfg,
+		lastNotify: make(map[string]time.Time),
+	}
 }
 
+// scanWorkers bounds how many (exchange, symbol, timeframe) tasks run their
+// blocking Analyze call at once, so one slow/hung call can only ever
+// head-of-line block this many other tasks, not the whole matrix.
+const scanWorkers = 8
+
+// Start runs the scan matrix on its own dispatcher goroutine plus a bounded
+// worker pool, firing each (exchange, symbol, timeframe) slot on its
+// configured interval via a priority queue of next fire times rather than a
+// single shared ticker. The dispatcher owns the heap exclusively; workers
+// only ever see tasks it hands them and hand back once scanOne returns.
 func (s *Scanner) Start() {
-	go func() {
-		ticker := time.NewTicker(2 * time.Minute)
-		defer ticker.Stop()
+	queue := s.buildQueue(time.Now())
+	if queue.Len() == 0 {
+		return
+	}
+
+	tasks := make(chan *scanTask)
+	done := make(chan *scanTask, scanWorkers)
+
+	for i := 0; i < scanWorkers; i++ {
+		go s.worker(tasks, done)
+	}
 
-		s.scan() // first scan immediately
+	go func() {
+		pending := 0
+		for queue.Len() > 0 || pending > 0 {
+			var fire <-chan time.Time
+			if queue.Len() > 0 {
+				wait := time.Until((*queue)[0].next)
+				if wait < 0 {
+					wait = 0
+				}
+				fire = time.After(wait)
+			}
 
-		for range ticker.C {
-			s.scan()
+			select {
+			case <-fire:
+				task := heap.Pop(queue).(*scanTask)
+				pending++
+				tasks <- task
+			case task := <-done:
+				pending--
+				queue.reschedule(task, time.Now())
+			}
 		}
 	}()
 }
 
-func (s *Scanner) scan() {
-	log.Println("🔍 Scanning market...")
+// worker runs tasks handed to it by the dispatcher one at a time and reports
+// completion back, so the dispatcher can reschedule without ever touching
+// the heap from more than one goroutine.
+func (s *Scanner) worker(tasks <-chan *scanTask, done chan<- *scanTask) {
+	for task := range tasks {
+		s.scanOne(task.exchange, task.symbol, task.timeframe)
+		done <- task
+	}
+}
 
-	for _, pair := range s.pairs {
-		resp, err := s.client.Analyze(pair, "15m", "binance")
-		if err != nil || resp.Side == "" {
-			continue
+func (s *Scanner) buildQueue(now time.Time) *taskQueue {
+	queue := &taskQueue{}
+	heap.Init(queue)
+	for _, ex := range s.cfg.Exchanges {
+		for _, pair := range s.cfg.Pairs {
+			for _, tf := range pair.Timeframes {
+				heap.Push(queue, &scanTask{
+					exchange:  ex,
+					symbol:    pair.Symbol,
+					timeframe: tf.Timeframe,
+					interval:  tf.Interval,
+					next:      now,
+				})
+			}
 		}
+	}
+	return queue
+}
 
-		lastSignal, exists := s.store.Get(pair, "15m")
-		newSignal := domain.Signal{
-			Symbol:     resp.Symbol,
-			Timeframe:  resp.Timeframe,
-			Side:       resp.Side,
-			EntryLow:   resp.EntryLow,
-			EntryHigh:  resp.EntryHigh,
-			StopLoss:   resp.StopLoss,
-			TakeProfit: resp.TakeProfit,
-		}
+func (s *Scanner) scanOne(exchangeKind, symbol, timeframe string) {
+	ex, ok := exchange.Get(exchangeKind)
+	if !ok {
+		log.Printf("Unknown exchange %q, skipping %s %s", exchangeKind, symbol, timeframe)
+		return
+	}
+	normalized := ex.Normalize(symbol)
 
-		// Send notification if:
-		// 1. No previous signal exists (new signal)
-		// 2. Signal has changed (different side or entry levels)
-		shouldNotify := !exists || s.signalChanged(lastSignal, newSignal)
+	resp, err := s.client.Analyze(normalized, timeframe, exchangeKind)
+	if err != nil || resp.Side == "" {
+		return
+	}
 
-		if shouldNotify {
-			msg := s.formatSignalMessage(newSignal)
-			if err := s.telegram.SendMessage(msg); err != nil {
-				log.Printf("Failed to send Telegram message for %s: %v", pair, err)
-			}
-			log.Printf("✅ Signal sent for %s: %s", pair, newSignal.Side)
+	lastSignal, exists, err := s.store.Latest(symbol, timeframe, exchangeKind)
+	if err != nil {
+		log.Printf("Failed to read last signal for %s %s on %s: %v", symbol, timeframe, exchangeKind, err)
+		return
+	}
+
+	emittedAt := time.Now()
+	newSignal := domain.Signal{
+		ID: domain.NewSignalID(symbol, timeframe, emittedAt),
+		// Stored/keyed on the un-normalized symbol so Latest/History/confluence
+		// lookups (which all take the original symbol, e.g. from the URL or the
+		// scan matrix) keep matching what was saved here, even on exchanges like
+		// Coinbase whose Normalize rewrites it (e.g. "BTCUSDT" -> "BTC-USD").
+		Symbol:     symbol,
+		Timeframe:  resp.Timeframe,
+		Exchange:   exchangeKind,
+		Side:       resp.Side,
+		EntryLow:   resp.EntryLow,
+		EntryHigh:  resp.EntryHigh,
+		StopLoss:   resp.StopLoss,
+		TakeProfit: resp.TakeProfit,
+		EmittedAt:  emittedAt,
+	}
+	roundToExchangePrecision(&newSignal, ex)
+
+	shouldNotify := !exists || s.signalChanged(lastSignal, newSignal)
+
+	if shouldNotify && s.tryNotify(symbol, newSignal.Side) {
+		msg := s.formatSignalMessage(newSignal)
+		s.notifySubscribers(symbol, timeframe, msg)
+		log.Printf("✅ Signal sent for %s %s on %s: %s", symbol, timeframe, exchangeKind, newSignal.Side)
+	}
+
+	if err := s.store.Save(newSignal); err != nil {
+		log.Printf("Failed to persist signal for %s %s on %s: %v", symbol, timeframe, exchangeKind, err)
+	}
+	s.bus.Publish(newSignal)
+	s.tracker.Track(newSignal)
+
+	s.checkConfluence(symbol, exchangeKind)
+}
+
+// roundToExchangePrecision rounds a signal's price levels to ex's valid tick
+// size before it's stored or sent. Symbols without known precision data are
+// left untouched.
+func roundToExchangePrecision(sig *domain.Signal, ex exchange.Exchange) {
+	priceTick, _, err := ex.TickSize(sig.Symbol)
+	if err != nil {
+		return
+	}
+	sig.EntryLow = exchange.RoundToTick(sig.EntryLow, priceTick)
+	sig.EntryHigh = exchange.RoundToTick(sig.EntryHigh, priceTick)
+	sig.StopLoss = exchange.RoundToTick(sig.StopLoss, priceTick)
+	sig.TakeProfit = exchange.RoundToTick(sig.TakeProfit, priceTick)
+}
+
+// tryNotify applies the cooldown/hysteresis layer keyed on (symbol, side) so
+// choppy conditions flipping signalChanged's threshold repeatedly don't cause
+// a notification storm.
+func (s *Scanner) tryNotify(symbol, side string) bool {
+	cooldown := s.cfg.Confluence.Cooldown
+	if cooldown <= 0 {
+		return true
+	}
+
+	key := symbol + "_" + side
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if last, ok := s.lastNotify[key]; ok && now.Sub(last) < cooldown {
+		return false
+	}
+	s.lastNotify[key] = now
+	return true
+}
+
+// checkConfluence looks up the latest signal on every configured confluence
+// timeframe for symbol/exchange and, if they all agree on side within the
+// staleness window, emits a domain.ConfluenceSignal and notifies subscribers.
+func (s *Scanner) checkConfluence(symbol, exchangeKind string) {
+	tfs := s.cfg.Confluence.Timeframes
+	if len(tfs) < 2 {
+		return
+	}
+
+	var side string
+	signalIDs := make([]string, 0, len(tfs))
+	now := time.Now()
+
+	for _, tf := range tfs {
+		sig, ok, err := s.store.Latest(symbol, tf, exchangeKind)
+		if err != nil || !ok {
+			return
+		}
+		if now.Sub(sig.EmittedAt) > s.cfg.Confluence.Staleness {
+			return
 		}
+		if side == "" {
+			side = sig.Side
+		} else if sig.Side != side {
+			return
+		}
+		signalIDs = append(signalIDs, sig.ID)
+	}
+
+	if side == "" || !s.tryNotify(symbol+"_"+exchangeKind+"_confluence", side) {
+		return
+	}
+
+	confluence := domain.ConfluenceSignal{
+		Symbol:     symbol,
+		Side:       side,
+		Timeframes: tfs,
+		SignalIDs:  signalIDs,
+		DetectedAt: now,
+	}
+	if err := s.confluence.Save(confluence); err != nil {
+		log.Printf("Failed to persist confluence event for %s on %s: %v", symbol, exchangeKind, err)
+	}
 
-		// Always update the stored signal
-		s.store.Save(newSignal)
+	msg := fmt.Sprintf("🧭 Confluence on %s (%s): %s aligned across %v", symbol, exchangeKind, side, tfs)
+	s.notifySubscribers(symbol, tfs[0], msg)
+	log.Printf("✅ Confluence detected for %s on %s: %s across %v", confluence.Symbol, exchangeKind, confluence.Side, confluence.Timeframes)
+}
+
+// notifySubscribers pushes msg to every chat that opted into this pair/timeframe.
+func (s *Scanner) notifySubscribers(symbol, timeframe, msg string) {
+	for _, chatID := range s.subs.ChatsFor(symbol, timeframe) {
+		if err := s.telegram.SendMessageTo(chatID, msg); err != nil {
+			log.Printf("Failed to send Telegram message to %s: %v", chatID, err)
+		}
 	}
 }
 
@@ -102,7 +298,8 @@ func (s *Scanner) signalChanged(lastSignal, newSignal domain.Signal) bool {
 // formatSignalMessage creates a formatted Telegram message
 func (s *Scanner) formatSignalMessage(signal domain.Signal) string {
 	return fmt.Sprintf(
-		"📊 SMC Alert!\nSymbol: %s\nTimeframe: %s\nSide: %s\nEntry: %.4f-%.4f\nSL: %.4f\nTP: %.4f",
+		"📊 SMC Alert! (%s)\nSymbol: %s\nTimeframe: %s\nSide: %s\nEntry: %.4f-%.4f\nSL: %.4f\nTP: %.4f",
+		signal.Exchange,
 		signal.Symbol,
 		signal.Timeframe,
 		signal.Side,
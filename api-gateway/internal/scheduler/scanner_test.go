@@ -0,0 +1,125 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"apigateway/internal/domain"
+	"apigateway/internal/repo"
+)
+
+func newTestScanner(cfg Config) *Scanner {
+	return NewScanner(nil, repo.NewMemoryStore(), repo.NewMemoryConfluenceStore(), nil, repo.NewSubscriptionStore(), nil, nil, cfg)
+}
+
+func TestScannerTryNotifyCooldown(t *testing.T) {
+	s := newTestScanner(Config{Confluence: ConfluenceConfig{Cooldown: 10 * time.Minute}})
+
+	if !s.tryNotify("BTCUSDT", "BUY") {
+		t.Fatal("first notification should be allowed")
+	}
+	if s.tryNotify("BTCUSDT", "BUY") {
+		t.Fatal("second notification within cooldown should be suppressed")
+	}
+
+	// Simulate the cooldown having already elapsed.
+	s.lastNotify["BTCUSDT_BUY"] = time.Now().Add(-11 * time.Minute)
+	if !s.tryNotify("BTCUSDT", "BUY") {
+		t.Fatal("notification after cooldown elapses should be allowed")
+	}
+}
+
+func TestScannerTryNotifyNoCooldownAlwaysAllows(t *testing.T) {
+	s := newTestScanner(Config{Confluence: ConfluenceConfig{Cooldown: 0}})
+
+	if !s.tryNotify("ETHUSDT", "SELL") || !s.tryNotify("ETHUSDT", "SELL") {
+		t.Fatal("a zero cooldown should never suppress notifications")
+	}
+}
+
+func TestScannerCheckConfluenceRequiresAgreementWithinStaleness(t *testing.T) {
+	cfg := Config{
+		Confluence: ConfluenceConfig{
+			Timeframes: []string{"15m", "1h"},
+			Staleness:  10 * time.Minute,
+		},
+	}
+	s := newTestScanner(cfg)
+
+	save := func(tf, side string, age time.Duration) {
+		err := s.store.Save(domain.Signal{
+			Symbol: "BTCUSDT", Timeframe: tf, Exchange: "binance",
+			Side: side, EmittedAt: time.Now().Add(-age),
+		})
+		if err != nil {
+			t.Fatalf("save: %v", err)
+		}
+	}
+
+	// Disagreeing sides: no confluence.
+	save("15m", "BUY", time.Minute)
+	save("1h", "SELL", time.Minute)
+	s.checkConfluence("BTCUSDT", "binance")
+	if _, ok := s.lastNotify["BTCUSDT_binance_confluence"]; ok {
+		t.Fatal("disagreeing timeframes should not trigger confluence")
+	}
+}
+
+func TestScannerCheckConfluenceIgnoresStaleSignals(t *testing.T) {
+	cfg := Config{
+		Confluence: ConfluenceConfig{
+			Timeframes: []string{"15m", "1h"},
+			Staleness:  5 * time.Minute,
+			Cooldown:   10 * time.Minute,
+		},
+	}
+	s := newTestScanner(cfg)
+
+	if err := s.store.Save(domain.Signal{Symbol: "ETHUSDT", Timeframe: "15m", Exchange: "binance", Side: "BUY", EmittedAt: time.Now()}); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	if err := s.store.Save(domain.Signal{Symbol: "ETHUSDT", Timeframe: "1h", Exchange: "binance", Side: "BUY", EmittedAt: time.Now().Add(-time.Hour)}); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+
+	s.checkConfluence("ETHUSDT", "binance")
+	if _, ok := s.lastNotify["ETHUSDT_binance_confluence_BUY"]; ok {
+		t.Fatal("a stale contributing signal should not trigger confluence")
+	}
+}
+
+func TestScannerCheckConfluenceFiresOnAgreement(t *testing.T) {
+	cfg := Config{
+		Confluence: ConfluenceConfig{
+			Timeframes: []string{"15m", "1h"},
+			Staleness:  10 * time.Minute,
+			Cooldown:   10 * time.Minute,
+		},
+	}
+	s := newTestScanner(cfg)
+
+	for _, tf := range cfg.Confluence.Timeframes {
+		if err := s.store.Save(domain.Signal{
+			Symbol: "SOLUSDT", Timeframe: tf, Exchange: "binance",
+			Side: "BUY", EmittedAt: time.Now(),
+		}); err != nil {
+			t.Fatalf("save: %v", err)
+		}
+	}
+
+	s.checkConfluence("SOLUSDT", "binance")
+	if _, ok := s.lastNotify["SOLUSDT_binance_confluence_BUY"]; !ok {
+		t.Fatal("agreeing, fresh signals across every configured timeframe should trigger confluence")
+	}
+
+	saved, err := s.confluence.ForSymbol("SOLUSDT", 10)
+	if err != nil {
+		t.Fatalf("ForSymbol: %v", err)
+	}
+	if len(saved) != 1 {
+		t.Fatalf("expected the confluence event to be persisted, got %d events", len(saved))
+	}
+	if saved[0].Side != "BUY" {
+		t.Errorf("saved confluence side = %q, want BUY", saved[0].Side)
+	}
+}
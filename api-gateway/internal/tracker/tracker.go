@@ -0,0 +1,151 @@
+// Package tracker follows up on emitted signals by polling live price and
+// recording how each one resolves (entry filled, TP hit, SL hit, expired),
+// closing the loop on the otherwise fire-and-forget scanner.
+package tracker
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"apigateway/internal/domain"
+	"apigateway/internal/grpc"
+	"apigateway/internal/repo"
+)
+
+const (
+	pollInterval = 30 * time.Second
+	expiryWindow = 24 * time.Hour // signals that never fill are marked expired after this long
+)
+
+type Tracker struct {
+	client   *grpc.SMCClient
+	outcomes repo.OutcomeStore
+	telegram *repo.Client
+	subs     *repo.SubscriptionStore
+}
+
+func NewTracker(client *grpc.SMCClient, outcomes repo.OutcomeStore, tg *repo.Client, subs *repo.SubscriptionStore) *Tracker {
+	return &Tracker{client: client, outcomes: outcomes, telegram: tg, subs: subs}
+}
+
+// Track begins tracking a freshly emitted signal's outcome.
+func (t *Tracker) Track(sig domain.Signal) {
+	o := domain.Outcome{Signal: sig, Status: domain.OutcomeOpen}
+	if err := t.outcomes.Save(o); err != nil {
+		log.Printf("tracker: failed to start tracking %s: %v", sig.ID, err)
+	}
+}
+
+// Start polls every open outcome on a fixed interval.
+func (t *Tracker) Start() {
+	go func() {
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			t.poll()
+		}
+	}()
+}
+
+func (t *Tracker) poll() {
+	open, err := t.outcomes.Open()
+	if err != nil {
+		log.Printf("tracker: failed to list open outcomes: %v", err)
+		return
+	}
+	for _, o := range open {
+		t.resolve(o)
+	}
+}
+
+func (t *Tracker) resolve(o domain.Outcome) {
+	price, err := t.client.CurrentPrice(o.Signal.Symbol, o.Signal.Exchange)
+	if err != nil {
+		return
+	}
+
+	switch classify(o.Signal, price) {
+	case domain.OutcomeEntryFilled:
+		if o.Status == domain.OutcomeOpen {
+			o.Status = domain.OutcomeEntryFilled
+			t.save(o)
+		}
+	case domain.OutcomeTPHit:
+		t.finish(o, domain.OutcomeTPHit, price, "🎯 TP hit")
+	case domain.OutcomeSLHit:
+		t.finish(o, domain.OutcomeSLHit, price, "🛑 SL hit")
+	default:
+		if o.Status == domain.OutcomeOpen && time.Since(o.Signal.EmittedAt) > expiryWindow {
+			o.Status = domain.OutcomeExpired
+			o.ResolvedAt = time.Now()
+			t.save(o)
+		}
+	}
+}
+
+func (t *Tracker) finish(o domain.Outcome, status domain.OutcomeStatus, price float64, headline string) {
+	o.Status = status
+	o.RMultiple = rMultiple(o.Signal, price)
+	o.ResolvedAt = time.Now()
+	t.save(o)
+	t.notify(o, headline)
+}
+
+// classify compares price against a signal's levels. It returns the open
+// status unchanged when none of the levels have been crossed yet.
+func classify(sig domain.Signal, price float64) domain.OutcomeStatus {
+	if sig.Side == "BUY" {
+		switch {
+		case price >= sig.TakeProfit:
+			return domain.OutcomeTPHit
+		case price <= sig.StopLoss:
+			return domain.OutcomeSLHit
+		case price >= sig.EntryLow && price <= sig.EntryHigh:
+			return domain.OutcomeEntryFilled
+		}
+		return domain.OutcomeOpen
+	}
+
+	// SELL
+	switch {
+	case price <= sig.TakeProfit:
+		return domain.OutcomeTPHit
+	case price >= sig.StopLoss:
+		return domain.OutcomeSLHit
+	case price >= sig.EntryLow && price <= sig.EntryHigh:
+		return domain.OutcomeEntryFilled
+	}
+	return domain.OutcomeOpen
+}
+
+// rMultiple expresses the move from entry to price in units of the signal's
+// own risk (entry to stop loss).
+func rMultiple(sig domain.Signal, price float64) float64 {
+	entryMid := (sig.EntryLow + sig.EntryHigh) / 2
+	risk := entryMid - sig.StopLoss
+	move := price - entryMid
+	if sig.Side == "SELL" {
+		risk = sig.StopLoss - entryMid
+		move = entryMid - price
+	}
+	if risk == 0 {
+		return 0
+	}
+	return move / risk
+}
+
+func (t *Tracker) notify(o domain.Outcome, headline string) {
+	msg := fmt.Sprintf("%s on %s %s %s, %+.1fR", headline, o.Signal.Symbol, o.Signal.Timeframe, o.Signal.Side, o.RMultiple)
+	for _, chatID := range t.subs.ChatsFor(o.Signal.Symbol, o.Signal.Timeframe) {
+		if err := t.telegram.SendMessageTo(chatID, msg); err != nil {
+			log.Printf("tracker: failed to notify %s: %v", chatID, err)
+		}
+	}
+}
+
+func (t *Tracker) save(o domain.Outcome) {
+	if err := t.outcomes.Save(o); err != nil {
+		log.Printf("tracker: failed to save outcome for %s: %v", o.Signal.ID, err)
+	}
+}
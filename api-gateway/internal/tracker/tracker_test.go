@@ -0,0 +1,64 @@
+package tracker
+
+import (
+	"testing"
+
+	"apigateway/internal/domain"
+)
+
+func TestClassify(t *testing.T) {
+	buy := domain.Signal{Side: "BUY", EntryLow: 100, EntryHigh: 110, StopLoss: 90, TakeProfit: 150}
+	sell := domain.Signal{Side: "SELL", EntryLow: 90, EntryHigh: 100, StopLoss: 110, TakeProfit: 50}
+
+	tests := []struct {
+		name  string
+		sig   domain.Signal
+		price float64
+		want  domain.OutcomeStatus
+	}{
+		{"buy: still open below entry", buy, 95, domain.OutcomeOpen},
+		{"buy: entry filled", buy, 105, domain.OutcomeEntryFilled},
+		{"buy: take profit hit", buy, 160, domain.OutcomeTPHit},
+		{"buy: stop loss hit", buy, 85, domain.OutcomeSLHit},
+		{"sell: still open above entry", sell, 105, domain.OutcomeOpen},
+		{"sell: entry filled", sell, 95, domain.OutcomeEntryFilled},
+		{"sell: take profit hit", sell, 40, domain.OutcomeTPHit},
+		{"sell: stop loss hit", sell, 115, domain.OutcomeSLHit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := classify(tt.sig, tt.price); got != tt.want {
+				t.Errorf("classify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRMultiple(t *testing.T) {
+	buy := domain.Signal{Side: "BUY", EntryLow: 100, EntryHigh: 120, StopLoss: 90}
+	sell := domain.Signal{Side: "SELL", EntryLow: 90, EntryHigh: 110, StopLoss: 120}
+
+	tests := []struct {
+		name  string
+		sig   domain.Signal
+		price float64
+		want  float64
+	}{
+		// entryMid=110, risk=110-90=20
+		{"buy at 2R profit", buy, 150, 2},
+		{"buy at 1R loss", buy, 90, -1},
+		// entryMid=100, risk=120-100=20
+		{"sell at 2R profit", sell, 60, 2},
+		{"sell at 1R loss", sell, 120, -1},
+		{"zero risk returns zero", domain.Signal{Side: "BUY", EntryLow: 100, EntryHigh: 100, StopLoss: 100}, 150, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rMultiple(tt.sig, tt.price); got != tt.want {
+				t.Errorf("rMultiple() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}